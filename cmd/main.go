@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"os"
 	"strings"
@@ -12,18 +13,105 @@ import (
 	"github.com/bug-crawler/pkg/backlog"
 	"github.com/bug-crawler/pkg/bitbucket"
 	"github.com/bug-crawler/pkg/cli"
+	"github.com/bug-crawler/pkg/config"
 	"github.com/bug-crawler/pkg/github"
+	"github.com/bug-crawler/pkg/jira"
 	"github.com/bug-crawler/pkg/platform"
 	"github.com/bug-crawler/pkg/report"
 )
 
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "compare":
+			runCompare(os.Args[2:])
+			return
+		case "labels":
+			runLabels()
+			return
+		}
+	}
+
 	printHeader()
 
-	// Initialize managers
-	tokenMgr := auth.NewTokenManager()
 	cliTool := cli.NewCLI()
 	ctx := context.Background()
+	cfg := loadConfig()
+	bots := newBotMatcher(cfg)
+
+	selectedPlatform, platformClient := setupPlatformClient(ctx, cliTool, cfg)
+
+	// Step 3: Select Scan Mode
+	scanMode := selectScanMode(cliTool)
+
+	// Step 4: Select Repositories
+	repos := selectRepositories(ctx, cliTool, platformClient)
+
+	// Step 5: Select Date Range
+	fmt.Println("\nStep 5: Chọn Khoảng Thời Gian")
+	fmt.Println("-" + strings.Repeat("-", 40) + "-")
+
+	startDate, endDate, err := cliTool.PromptDateRange()
+	if err != nil {
+		fmt.Println("❌ Lỗi khi nhập ngày:", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("✓ Sẽ phân tích PR từ %s đến %s\n", startDate.Format("2006-01-02"), endDate.Format("2006-01-02"))
+
+	// Step 6: Select Bug Type (if in bug detection mode)
+	bugType := selectBugType(cliTool, scanMode)
+
+	opts := selectScanOptions(cliTool, scanMode, bugType, platformClient)
+
+	// Step 7: Crawler PR
+	snapshot := runScan(ctx, platformClient, selectedPlatform, repos, startDate, endDate, scanMode, bugType, opts, cfg, bots)
+
+	// Step 8: Report Results
+	fmt.Println("\nStep 8: Thống Kê Kết Quả")
+	fmt.Println("-" + strings.Repeat("-", 40) + "-")
+
+	printScanReport(snapshot)
+	printBugIssueReport(snapshot)
+	printLeadTimeReport(snapshot, bots)
+	printHotspotReport(snapshot, cfg)
+	printFollowUpReport(snapshot, cfg)
+	printEscapeReport(snapshot, cfg)
+
+	fmt.Println("\n✓ Hoàn thành!")
+}
+
+// loadConfig loads the optional config file, exiting on invalid content
+func loadConfig() *config.Config {
+	cfg, err := config.Load(config.DefaultPath())
+	if err != nil {
+		fmt.Println("❌ Lỗi khi đọc file config:", err)
+		os.Exit(1)
+	}
+	if cfg.Path() != "" {
+		fmt.Printf("✓ Đã đọc cấu hình từ %s\n", cfg.Path())
+	}
+	return cfg
+}
+
+// newBotMatcher compiles the bot list of the config (authors.bots), exiting on invalid patterns.
+// The same matcher leaves out bot authors and ignores bot reviews in the rules and lead times.
+func newBotMatcher(cfg *config.Config) *analyzer.BotMatcher {
+	var patterns []string
+	if cfg.Authors != nil {
+		patterns = cfg.Authors.Bots
+	}
+	bots, err := analyzer.NewBotMatcher(patterns)
+	if err != nil {
+		fmt.Println("❌ Cấu hình authors.bots không hợp lệ:", err)
+		os.Exit(1)
+	}
+	return bots
+}
+
+// setupPlatformClient selects the platform, authenticates and returns a verified client (steps 0-2)
+func setupPlatformClient(ctx context.Context, cliTool *cli.CLI, cfg *config.Config) (string, platform.Platform) {
+	tokenMgr := auth.NewTokenManager()
 
 	// Step 0: Select Platform
 	fmt.Println("\nStep 0: Chọn Platform")
@@ -136,7 +224,12 @@ func main() {
 	case "bitbucket":
 		platformClient, err = bitbucket.NewClient(email, token)
 	case "backlog":
-		platformClient, err = backlog.NewClient(spaceID, token, domain)
+		var client *backlog.Client
+		client, err = backlog.NewClient(spaceID, token, domain)
+		if err == nil {
+			client.SetStarsAsApprovals(cfg.Backlog != nil && cfg.Backlog.StarsAsApprovals)
+			platformClient = client
+		}
 	default:
 		fmt.Printf("❌ Platform không được hỗ trợ: %s\n", selectedPlatform)
 		os.Exit(1)
@@ -154,7 +247,11 @@ func main() {
 	}
 	fmt.Println("✓ Token xác thực thành công")
 
-	// Step 3: Select Scan Mode
+	return selectedPlatform, platformClient
+}
+
+// selectScanMode prompts for the scan mode (step 3)
+func selectScanMode(cliTool *cli.CLI) string {
 	fmt.Println("\nStep 3: Chọn Chế Độ Scan")
 	fmt.Println("-" + strings.Repeat("-", 40) + "-")
 
@@ -164,7 +261,11 @@ func main() {
 		os.Exit(1)
 	}
 
-	// Step 4: Select Repositories
+	return scanMode
+}
+
+// selectRepositories lets the user pick the repositories to scan (step 4)
+func selectRepositories(ctx context.Context, cliTool *cli.CLI, platformClient platform.Platform) []string {
 	fmt.Println("\nStep 4: Chọn Repositories")
 	fmt.Println("-" + strings.Repeat("-", 40) + "-")
 
@@ -261,20 +362,13 @@ func main() {
 	}
 	fmt.Println(strings.Repeat("=", 43))
 
-	// Step 5: Select Date Range
-	fmt.Println("\nStep 5: Chọn Khoảng Thời Gian")
-	fmt.Println("-" + strings.Repeat("-", 40) + "-")
-
-	startDate, endDate, err := cliTool.PromptDateRange()
-	if err != nil {
-		fmt.Println("❌ Lỗi khi nhập ngày:", err)
-		os.Exit(1)
-	}
-
-	fmt.Printf("✓ Sẽ phân tích PR từ %s đến %s\n", startDate.Format("2006-01-02"), endDate.Format("2006-01-02"))
+	return repos
+}
 
-	// Step 6: Select Bug Type (if in bug detection mode)
+// selectBugType prompts for the bug type in bug detection mode (step 6)
+func selectBugType(cliTool *cli.CLI, scanMode string) string {
 	var bugType string
+	var err error
 	if scanMode == "bug" {
 		fmt.Println("\nStep 6: Chọn Loại Bug")
 		fmt.Println("-" + strings.Repeat("-", 40) + "-")
@@ -285,9 +379,16 @@ func main() {
 			os.Exit(1)
 		}
 
-		if bugType == "bug" {
-			fmt.Println("✓ Sẽ scan bug từ labels")
-		} else {
+		switch bugType {
+		case "bug":
+			fmt.Println("✓ Sẽ scan bug từ labels, type: bug và tên branch")
+		case "title":
+			fmt.Println("✓ Sẽ scan bug theo tiêu đề PR (Conventional Commits)")
+		case "combined":
+			fmt.Println("✓ Sẽ scan kết hợp label, type: bug, bug_review, branch, tiêu đề PR và issue liên kết")
+		case "issue":
+			fmt.Println("✓ Sẽ scan bug theo loại của issue liên kết")
+		default:
 			fmt.Println("✓ Sẽ scan bug_review")
 		}
 	} else {
@@ -296,23 +397,100 @@ func main() {
 		fmt.Println("✓ Sẽ scan PR theo quy tắc code review")
 	}
 
-	// Step 7: Crawler PR
+	return bugType
+}
+
+// scanOptions selects the optional per-PR data fetched during a scan
+type scanOptions struct {
+	withReviews   bool // Reviews, for PR rules, bug_review tags in review comments and lead-time metrics
+	withStats     bool // Diff statistics, for the bug ratio per PR size
+	withFiles     bool // Changed files of bug-related PRs, for bug hotspots
+	withBugIssues bool // Bug issues of the scanned projects, if the platform has an issue tracker
+}
+
+// selectScanOptions asks which optional data to fetch. Reviews are always fetched in PR rules mode;
+// in bug mode they are only needed for bug_review tags in review comments and lead-time metrics.
+func selectScanOptions(cliTool *cli.CLI, scanMode, bugType string, platformClient platform.Platform) scanOptions {
+	if scanMode == "pr_rules" {
+		return scanOptions{withReviews: true}
+	}
+
+	var opts scanOptions
+	var err error
+	promptFetchReviews := cliTool.PromptFetchReviews
+	if bugType == "bug_review" || bugType == "combined" {
+		promptFetchReviews = cliTool.PromptFetchReviewTags
+	}
+	if opts.withReviews, err = promptFetchReviews(); err != nil {
+		fmt.Println("❌ Lỗi khi chọn:", err)
+		os.Exit(1)
+	}
+	if opts.withStats, err = cliTool.PromptFetchStats(); err != nil {
+		fmt.Println("❌ Lỗi khi chọn:", err)
+		os.Exit(1)
+	}
+	if opts.withFiles, err = cliTool.PromptFetchFiles(); err != nil {
+		fmt.Println("❌ Lỗi khi chọn:", err)
+		os.Exit(1)
+	}
+	if _, ok := platformClient.(platform.BugIssueSource); ok {
+		if opts.withBugIssues, err = cliTool.PromptFetchBugIssues(); err != nil {
+			fmt.Println("❌ Lỗi khi chọn:", err)
+			os.Exit(1)
+		}
+	}
+	return opts
+}
+
+// runScan crawls PRs from the selected repositories and analyzes them (step 7)
+func runScan(ctx context.Context, platformClient platform.Platform, selectedPlatform string, repos []string, startDate, endDate time.Time, scanMode, bugType string, opts scanOptions, cfg *config.Config, bots *analyzer.BotMatcher) *report.ScanSnapshot {
 	fmt.Println("\nStep 7: Crawler PR từ " + strings.ToUpper(selectedPlatform))
 	fmt.Println("-" + strings.Repeat("-", 40) + "-")
 
 	startTime := time.Now()
-	bugAnalyzer := analyzer.NewBugAnalyzer()
-	prRuleAnalyzer := analyzer.NewPRRuleAnalyzer()
-	allResults := make([]*analyzer.BugResult, 0)
-	allPRRuleResults := make([]*analyzer.PRRuleResult, 0)
-	totalPRsCrawled := 0
-
-	maxWorkers := 3
-	if len(repos) < 3 {
-		maxWorkers = len(repos)
+	bugAnalyzer, err := analyzer.NewBugAnalyzerWithConfig(cfg.BugDetection)
+	if err != nil {
+		fmt.Println("❌ Cấu hình bug detection không hợp lệ:", err)
+		os.Exit(1)
+	}
+	prRuleAnalyzer, err := analyzer.NewPRRuleAnalyzerWithConfig(cfg.PRRules)
+	if err != nil {
+		fmt.Println("❌ Cấu hình PR rules không hợp lệ:", err)
+		os.Exit(1)
+	}
+	locales, err := analyzer.NewLocaleSelector(cfg.Locales)
+	if err != nil {
+		fmt.Println("❌ Cấu hình locales không hợp lệ:", err)
+		os.Exit(1)
+	}
+	bugAnalyzer.SetLocales(locales)
+	prRuleAnalyzer.SetLocales(locales)
+	prRuleAnalyzer.SetBots(bots)
+	if source, ok := platformClient.(platform.ApprovalSource); ok && !source.ReportsApprovals() {
+		prRuleAnalyzer.SetApprovalsAvailable(false)
+	}
+	authorFilter, err := analyzer.NewAuthorFilter(cfg.Authors, bots)
+	if err != nil {
+		fmt.Println("❌ Cấu hình authors không hợp lệ:", err)
+		os.Exit(1)
 	}
-	if len(repos) > 10 {
-		maxWorkers = 5
+	snapshot := &report.ScanSnapshot{
+		Platform:      selectedPlatform,
+		ScanMode:      scanMode,
+		BugType:       bugType,
+		Repositories:  repos,
+		StartDate:     startDate,
+		EndDate:       endDate,
+		BugResults:    make([]*analyzer.BugResult, 0),
+		PRRuleResults: make([]*analyzer.PRRuleResult, 0),
+		ExcludedPRs:   make(map[string]int),
+	}
+
+	maxWorkers := scanWorkers(len(repos))
+
+	var jiraClient *jira.Client
+	if bugType == "issue" || bugType == "combined" {
+		jiraClient = newJiraClient(cfg)
 	}
 
 	fmt.Printf("🚀 Quét %d repositories với %d workers (song song)...\n", len(repos), maxWorkers)
@@ -328,15 +506,27 @@ func main() {
 			continue
 		}
 
-		fmt.Printf("✓ %s/%s: %d PR\n", job.Owner, job.RepoName, len(job.PRData))
-		totalPRsCrawled += len(job.PRData)
+		// Filter authors before fetching details, so excluded PRs cost no API calls
+		prs, excluded := authorFilter.Filter(job.PRData)
+		excludedCount := len(job.PRData) - len(prs)
+		job.PRData = prs
+		for reason, n := range excluded {
+			snapshot.ExcludedPRs[reason] += n
+		}
 
-		if scanMode == "pr_rules" && len(job.PRData) > 0 {
-			prNumbers := make([]int, len(job.PRData))
-			for i, pr := range job.PRData {
-				prNumbers[i] = pr.Number
-			}
+		if excludedCount > 0 {
+			fmt.Printf("✓ %s/%s: %d PR (loại %d PR theo author)\n", job.Owner, job.RepoName, len(job.PRData), excludedCount)
+		} else {
+			fmt.Printf("✓ %s/%s: %d PR\n", job.Owner, job.RepoName, len(job.PRData))
+		}
+		snapshot.TotalPRsCrawled += len(job.PRData)
 
+		prNumbers := make([]int, len(job.PRData))
+		for i, pr := range job.PRData {
+			prNumbers[i] = pr.Number
+		}
+
+		if opts.withReviews && len(job.PRData) > 0 {
 			reviewsMap, err := platformClient.GetPullRequestReviewsConcurrent(ctx, job.Owner, job.RepoName, prNumbers, 5)
 			if err != nil {
 				// Silently continue on error
@@ -349,65 +539,571 @@ func main() {
 			}
 		}
 
+		if opts.withStats && len(job.PRData) > 0 {
+			statsMap, err := platformClient.GetPullRequestStatsConcurrent(ctx, job.Owner, job.RepoName, prNumbers, 5)
+			if err == nil {
+				for _, pr := range job.PRData {
+					if stats, exists := statsMap[pr.Number]; exists {
+						pr.ApplyStats(stats)
+					}
+				}
+			}
+		}
+
+		if bugType == "issue" || bugType == "combined" {
+			resolveLinkedIssues(ctx, platformClient, jiraClient, bugAnalyzer, selectedPlatform, job)
+		}
+
 		if scanMode == "pr_rules" {
 			results := prRuleAnalyzer.AnalyzePRRules(job.PRData)
-			allPRRuleResults = append(allPRRuleResults, results...)
+			snapshot.PRRuleResults = append(snapshot.PRRuleResults, results...)
 		} else {
 			results := bugAnalyzer.AnalyzePRs(job.PRData, bugType, selectedPlatform)
-			allResults = append(allResults, results...)
+			if opts.withFiles {
+				fetchBugPRFiles(ctx, platformClient, job, results)
+			}
+			snapshot.BugResults = append(snapshot.BugResults, results...)
 		}
 	}
 
+	if opts.withBugIssues {
+		snapshot.BugIssues = fetchBugIssues(ctx, platformClient, repos, startDate, endDate, cfg)
+	}
+
 	elapsedTime := time.Since(startTime)
 	fmt.Printf("✓ Hoàn thành crawl trong: %.2f giây\n", elapsedTime.Seconds())
 
-	// Step 8: Report Results
-	fmt.Println("\nStep 8: Thống Kê Kết Quả")
-	fmt.Println("-" + strings.Repeat("-", 40) + "-")
+	return snapshot
+}
+
+// scanWorkers returns the number of repositories crawled in parallel
+func scanWorkers(repoCount int) int {
+	if repoCount > 10 {
+		return 5
+	}
+	if repoCount < 3 {
+		return repoCount
+	}
+	return 3
+}
 
+// fetchBugIssues retrieves the bug issues created in the projects of the scanned repositories ("PROJECT/repo")
+func fetchBugIssues(ctx context.Context, platformClient platform.Platform, repos []string, startDate, endDate time.Time, cfg *config.Config) []*platform.IssueData {
+	source, ok := platformClient.(platform.BugIssueSource)
+	if !ok {
+		return nil
+	}
+
+	issueTypes := analyzer.DefaultIssueBugTypes
+	if cfg.BugDetection != nil && len(cfg.BugDetection.IssueBugTypes) > 0 {
+		issueTypes = cfg.BugDetection.IssueBugTypes
+	}
+
+	issues := make([]*platform.IssueData, 0)
+	seen := make(map[string]bool)
+	for _, repo := range repos {
+		project := strings.SplitN(repo, "/", 2)[0]
+		if seen[project] {
+			continue
+		}
+		seen[project] = true
+
+		projectIssues, err := source.GetBugIssues(ctx, project, issueTypes, startDate, endDate)
+		if err != nil {
+			fmt.Printf("⚠️  Không lấy được issue bug của %s: %v\n", project, err)
+			continue
+		}
+		fmt.Printf("✓ %s: %d issue bug\n", project, len(projectIssues))
+		issues = append(issues, projectIssues...)
+	}
+	return issues
+}
+
+// newJiraClient creates the Jira issue resolver if configured, nil otherwise.
+// The token is read from $JIRA_API_TOKEN or ~/.config/bug-crawler/jira_token.
+func newJiraClient(cfg *config.Config) *jira.Client {
+	if cfg.Jira == nil {
+		return nil
+	}
+
+	token, err := auth.NewTokenManager().GetTokenForPlatform("jira")
+	if err != nil {
+		fmt.Println("⚠️  Bỏ qua Jira: cần JIRA_API_TOKEN hoặc file ~/.config/bug-crawler/jira_token")
+		return nil
+	}
+
+	client, err := jira.NewClient(cfg.Jira.BaseURL, cfg.Jira.Email, token)
+	if err != nil {
+		fmt.Println("⚠️  Bỏ qua Jira:", err)
+		return nil
+	}
+
+	fmt.Printf("✓ Tra cứu issue trên Jira: %s\n", cfg.Jira.BaseURL)
+	return client
+}
+
+// resolveLinkedIssues resolves the issues referenced by the PRs of a repository on the platform,
+// then on Jira if configured. Every issue is looked up once, even if several PRs reference it.
+func resolveLinkedIssues(ctx context.Context, platformClient platform.Platform, jiraClient *jira.Client, bugAnalyzer *analyzer.BugAnalyzer, selectedPlatform string, job platform.RepositoryScanJob) {
+	// Issue keys of the Backlog project are always references, other projects must be configured
+	var knownProjectKeys []string
+	if selectedPlatform == "backlog" {
+		knownProjectKeys = append(knownProjectKeys, job.Owner)
+	}
+
+	prRefs := make(map[int][]string)
+	var refs []string
+	seen := make(map[string]bool)
+	for _, pr := range job.PRData {
+		prRefs[pr.Number] = bugAnalyzer.ExtractIssueRefs(pr, knownProjectKeys...)
+		for _, ref := range prRefs[pr.Number] {
+			if !seen[ref] {
+				seen[ref] = true
+				refs = append(refs, ref)
+			}
+		}
+	}
+	if len(refs) == 0 {
+		return
+	}
+
+	issues, err := platformClient.GetIssuesConcurrent(ctx, job.Owner, job.RepoName, refs, 5)
+	if err != nil {
+		issues = make(map[string]*platform.IssueData)
+	}
+
+	if jiraClient != nil {
+		var unresolved []string
+		for _, ref := range refs {
+			if _, exists := issues[ref]; !exists {
+				unresolved = append(unresolved, ref)
+			}
+		}
+		jiraIssues, err := jiraClient.GetIssuesConcurrent(ctx, unresolved, 5)
+		if err == nil {
+			for ref, issue := range jiraIssues {
+				issues[ref] = issue
+			}
+		}
+	}
+	for _, pr := range job.PRData {
+		// Keep the issues already linked by the platform (Backlog PR "issue" field)
+		linked := make(map[string]bool)
+		for _, issue := range pr.LinkedIssues {
+			linked[issue.Key] = true
+		}
+		if pr.LinkedIssues == nil {
+			pr.LinkedIssues = make([]*platform.IssueData, 0)
+		}
+		for _, ref := range prRefs[pr.Number] {
+			if issue, exists := issues[ref]; exists && !linked[ref] {
+				pr.LinkedIssues = append(pr.LinkedIssues, issue)
+			}
+		}
+	}
+}
+
+// fetchBugPRFiles fetches the changed files of the bug-related PRs of a repository
+func fetchBugPRFiles(ctx context.Context, platformClient platform.Platform, job platform.RepositoryScanJob, results []*analyzer.BugResult) {
+	var prNumbers []int
+	for _, result := range results {
+		if result.IsBugRelated {
+			prNumbers = append(prNumbers, result.PR.Number)
+		}
+	}
+	if len(prNumbers) == 0 {
+		return
+	}
+
+	filesMap, err := platformClient.GetPullRequestFilesConcurrent(ctx, job.Owner, job.RepoName, prNumbers, 5)
+	if err != nil {
+		return
+	}
+	for _, result := range results {
+		if files, exists := filesMap[result.PR.Number]; exists {
+			result.PR.Files = files
+		}
+	}
+}
+
+// filterBugResults keeps the results detected by the selected bug type
+func filterBugResults(results []*analyzer.BugResult, bugType string) []*analyzer.BugResult {
+	var filteredResults []*analyzer.BugResult
+	switch bugType {
+	case "bug_review":
+		for _, result := range results {
+			if result.DetectionType == "bug_review" {
+				filteredResults = append(filteredResults, result)
+			}
+		}
+	case "bug":
+		for _, result := range results {
+			if result.DetectionType == "label" || result.DetectionType == "description_regex" || result.DetectionType == "branch" {
+				filteredResults = append(filteredResults, result)
+			}
+		}
+	case "title", "combined":
+		for _, result := range results {
+			if result.DetectionType == bugType {
+				filteredResults = append(filteredResults, result)
+			}
+		}
+	case "issue":
+		for _, result := range results {
+			if result.DetectionType == "linked_issue" {
+				filteredResults = append(filteredResults, result)
+			}
+		}
+	}
+	return filteredResults
+}
+
+// printScanReport prints the scan results and exports them to CSV and JSON (step 8)
+func printScanReport(snapshot *report.ScanSnapshot) {
 	reporter := report.NewReporter()
 
-	if scanMode == "pr_rules" {
-		reporter.PrintPRRulesSummary(allPRRuleResults)
-		reporter.PrintPRRulesDetails(allPRRuleResults)
+	if snapshot.ScanMode == "pr_rules" {
+		reporter.PrintPRRulesSummary(snapshot.PRRuleResults)
+		reporter.PrintExcludedPRs(snapshot.ExcludedPRs)
+		reporter.PrintPRRulesDetails(snapshot.PRRuleResults)
 
 		csvFile := "pr_rules_report.csv"
-		if err := reporter.ExportPRRulesCSV(csvFile, allPRRuleResults); err != nil {
+		if err := reporter.ExportPRRulesCSV(csvFile, snapshot.PRRuleResults); err != nil {
 			fmt.Printf("❌ Lỗi khi export CSV: %v\n", err)
 		}
-	} else {
-		var filteredResults []*analyzer.BugResult
-		switch bugType {
-		case "bug_review":
-			for _, result := range allResults {
-				if result.DetectionType == "bug_review" {
-					filteredResults = append(filteredResults, result)
-				}
+
+		if err := reporter.ExportJSON("pr_rules_report.json", snapshot); err != nil {
+			fmt.Printf("❌ Lỗi khi export JSON: %v\n", err)
+		}
+
+		reviewers := report.BuildReviewerStats(snapshot.PRRuleResults)
+		if len(reviewers) > 0 {
+			reporter.PrintReviewerLeaderboard(reviewers)
+			if err := reporter.ExportReviewerStatsCSV("reviewer_report.csv", reviewers); err != nil {
+				fmt.Printf("❌ Lỗi khi export CSV: %v\n", err)
 			}
-		case "bug":
-			for _, result := range allResults {
-				if result.DetectionType == "label" || result.DetectionType == "description_regex" {
-					filteredResults = append(filteredResults, result)
-				}
+			if err := reporter.ExportReviewerStatsJSON("reviewer_report.json", reviewers); err != nil {
+				fmt.Printf("❌ Lỗi khi export JSON: %v\n", err)
 			}
 		}
+		return
+	}
+
+	filteredResults := filterBugResults(snapshot.BugResults, snapshot.BugType)
+
+	stats := reporter.GenerateStatistics(filteredResults)
+	stats.TotalPRsCrawled = snapshot.TotalPRsCrawled
+	stats.ExcludedPRs = snapshot.ExcludedPRs
 
-		stats := reporter.GenerateStatistics(filteredResults)
-		stats.TotalPRsCrawled = totalPRsCrawled
+	if stats.TotalPRsCrawled > 0 {
+		stats.BugPercentage = float64(stats.BugRelatedPRs) * 100 / float64(stats.TotalPRsCrawled)
+	}
+
+	reporter.PrintSummary(stats)
+	reporter.PrintDetails(stats)
 
-		if stats.TotalPRsCrawled > 0 {
-			stats.BugPercentage = float64(stats.BugRelatedPRs) * 100 / float64(stats.TotalPRsCrawled)
+	if stats.BugRelatedPRs > 0 {
+		csvFile := "bug_report.csv"
+		if err := reporter.ExportCSV(csvFile, stats); err != nil {
+			fmt.Printf("❌ Lỗi khi export CSV: %v\n", err)
 		}
+	}
 
-		reporter.PrintSummary(stats)
-		reporter.PrintDetails(stats)
+	if err := reporter.ExportJSON("bug_report.json", snapshot); err != nil {
+		fmt.Printf("❌ Lỗi khi export JSON: %v\n", err)
+	}
 
-		if stats.BugRelatedPRs > 0 {
-			csvFile := "bug_report.csv"
-			if err := reporter.ExportCSV(csvFile, stats); err != nil {
-				fmt.Printf("❌ Lỗi khi export CSV: %v\n", err)
-			}
+	scopes := report.BuildScopeStats(snapshot.BugResults)
+	if len(scopes) > 0 {
+		reporter.PrintScopeStats(scopes)
+		if err := reporter.ExportScopeStatsCSV("scope_report.csv", scopes); err != nil {
+			fmt.Printf("❌ Lỗi khi export CSV: %v\n", err)
+		}
+	}
+
+	sizes := report.BuildSizeStats(snapshot.BugResults)
+	if len(sizes) > 0 {
+		reporter.PrintSizeStats(sizes)
+		if err := reporter.ExportSizeStatsCSV("size_report.csv", sizes); err != nil {
+			fmt.Printf("❌ Lỗi khi export CSV: %v\n", err)
+		}
+	}
+}
+
+// printBugIssueReport prints and exports the bug issues of the scanned projects next to their bug-related PRs
+func printBugIssueReport(snapshot *report.ScanSnapshot) {
+	if snapshot.BugIssues == nil {
+		return
+	}
+
+	var projects []string
+	for _, repo := range snapshot.Repositories {
+		projects = append(projects, strings.SplitN(repo, "/", 2)[0])
+	}
+
+	reporter := report.NewReporter()
+	bugIssues := report.BuildBugIssueReport(projects, snapshot.BugIssues, filterBugResults(snapshot.BugResults, snapshot.BugType))
+	reporter.PrintBugIssueReport(bugIssues)
+	if err := reporter.ExportBugIssueCSV("bug_issues_report.csv", bugIssues); err != nil {
+		fmt.Printf("❌ Lỗi khi export CSV: %v\n", err)
+	}
+}
+
+// printLeadTimeReport prints and exports lead-time percentiles of the scanned PRs
+func printLeadTimeReport(snapshot *report.ScanSnapshot, bots *analyzer.BotMatcher) {
+	var prs []*platform.PullRequestData
+	for _, result := range snapshot.BugResults {
+		prs = append(prs, result.PR)
+	}
+	for _, result := range snapshot.PRRuleResults {
+		prs = append(prs, result.PR)
+	}
+	if len(prs) == 0 {
+		return
+	}
+
+	reporter := report.NewReporter()
+	leadTime := report.BuildLeadTimeReport(analyzer.NewMetricsAnalyzer(bots).AnalyzePRs(prs))
+	reporter.PrintLeadTimeReport(leadTime)
+	if err := reporter.ExportLeadTimeCSV("lead_time_report.csv", leadTime); err != nil {
+		fmt.Printf("❌ Lỗi khi export CSV: %v\n", err)
+	}
+}
+
+// printHotspotReport prints and exports the bug hotspots of the scanned PRs whose changed files were fetched
+func printHotspotReport(snapshot *report.ScanSnapshot, cfg *config.Config) {
+	hotspotAnalyzer, err := analyzer.NewHotspotAnalyzer(cfg.Hotspots)
+	if err != nil {
+		fmt.Println("❌ Cấu hình hotspots không hợp lệ:", err)
+		return
+	}
+
+	hotspots := report.BuildHotspotReport(filterBugResults(snapshot.BugResults, snapshot.BugType), hotspotAnalyzer)
+	if hotspots.PRsWithFiles == 0 {
+		return
+	}
+
+	reporter := report.NewReporter()
+	reporter.PrintHotspotReport(hotspots)
+	if err := reporter.ExportHotspotCSV("hotspot_report.csv", hotspots); err != nil {
+		fmt.Printf("❌ Lỗi khi export CSV: %v\n", err)
+	}
+}
+
+// printFollowUpReport prints and exports the merged PRs later reverted or fixed, with their caused-by links
+func printFollowUpReport(snapshot *report.ScanSnapshot, cfg *config.Config) {
+	if len(snapshot.BugResults) == 0 {
+		return
+	}
+
+	days := 0
+	if cfg.BugDetection != nil {
+		days = cfg.BugDetection.FollowUpDays
+	}
+	followUpAnalyzer, err := analyzer.NewFollowUpAnalyzer(days)
+	if err != nil {
+		fmt.Println("❌ Cấu hình bug detection không hợp lệ:", err)
+		return
+	}
+
+	reporter := report.NewReporter()
+	// Fixes are the bug PRs of the scanned bug type; all PRs are candidate originals and count as merged
+	fixes := filterBugResults(snapshot.BugResults, snapshot.BugType)
+	followUp := report.BuildFollowUpReport(snapshot.BugResults, followUpAnalyzer.Analyze(snapshot.BugResults, fixes), followUpAnalyzer.Days())
+	if followUp.Overall.MergedPRs == 0 {
+		return
+	}
+	reporter.PrintFollowUpReport(followUp)
+	if err := reporter.ExportFollowUpCSV("followup_report.csv", followUp); err != nil {
+		fmt.Printf("❌ Lỗi khi export CSV: %v\n", err)
+	}
+	if len(followUp.Links) > 0 {
+		if err := reporter.ExportFollowUpLinksCSV("followup_links.csv", followUp); err != nil {
+			fmt.Printf("❌ Lỗi khi export CSV: %v\n", err)
+		}
+	}
+}
+
+// printEscapeReport prints and exports the share of bug PRs fixed on release or main branches
+func printEscapeReport(snapshot *report.ScanSnapshot, cfg *config.Config) {
+	escapeAnalyzer, err := analyzer.NewEscapeAnalyzer(cfg.Escape)
+	if err != nil {
+		fmt.Println("❌ Cấu hình escape không hợp lệ:", err)
+		return
+	}
+
+	escape := report.BuildEscapeReport(filterBugResults(snapshot.BugResults, snapshot.BugType), escapeAnalyzer)
+	if escape.Overall.BugPRs == 0 {
+		return
+	}
+
+	reporter := report.NewReporter()
+	reporter.PrintEscapeReport(escape)
+	if err := reporter.ExportEscapeCSV("escape_report.csv", escape); err != nil {
+		fmt.Printf("❌ Lỗi khi export CSV: %v\n", err)
+	}
+	if len(escape.EscapedPRs) > 0 {
+		if err := reporter.ExportEscapedPRsCSV("escaped_bugs.csv", escape); err != nil {
+			fmt.Printf("❌ Lỗi khi export CSV: %v\n", err)
+		}
+	}
+}
+
+// runCompare implements the "compare" command: diff two scans over different date ranges
+func runCompare(args []string) {
+	fs := flag.NewFlagSet("compare", flag.ExitOnError)
+	basePath := fs.String("base", "", "File JSON đã lưu của kỳ trước (ví dụ: bug_report.json)")
+	headPath := fs.String("head", "", "File JSON đã lưu của kỳ này")
+	outPrefix := fs.String("out", "compare_report", "Tên file output (không có đuôi) cho Markdown và CSV")
+	_ = fs.Parse(args)
+
+	printHeader()
+
+	var base, head *report.ScanSnapshot
+	var err error
+
+	switch {
+	case *basePath != "" && *headPath != "":
+		if base, err = report.LoadSnapshot(*basePath); err != nil {
+			fmt.Println("❌ Lỗi khi đọc kết quả kỳ trước:", err)
+			os.Exit(1)
+		}
+		if head, err = report.LoadSnapshot(*headPath); err != nil {
+			fmt.Println("❌ Lỗi khi đọc kết quả kỳ này:", err)
+			os.Exit(1)
 		}
+	case *basePath != "" || *headPath != "":
+		fmt.Println("❌ Cần cung cấp cả --base và --head, hoặc bỏ trống cả 2 để scan trực tiếp")
+		os.Exit(1)
+	default:
+		base, head = scanTwoPeriods()
+	}
+
+	comparison, err := report.Compare(base, head)
+	if err != nil {
+		fmt.Println("❌", err)
+		os.Exit(1)
+	}
+
+	reporter := report.NewReporter()
+	reporter.PrintComparison(comparison)
+
+	if err := reporter.ExportComparisonMarkdown(*outPrefix+".md", comparison); err != nil {
+		fmt.Printf("❌ Lỗi khi export Markdown: %v\n", err)
+	}
+	if err := reporter.ExportComparisonCSV(*outPrefix+".csv", comparison); err != nil {
+		fmt.Printf("❌ Lỗi khi export CSV: %v\n", err)
+	}
+
+	fmt.Println("\n✓ Hoàn thành!")
+}
+
+// compareScanOptions returns the data fetched for both periods of a comparison: reviews whenever the
+// compared numbers depend on them (PR rules, bug_review tags in review comments), nothing else.
+func compareScanOptions(scanMode, bugType string) scanOptions {
+	return scanOptions{withReviews: scanMode == "pr_rules" || bugType == "bug_review" || bugType == "combined"}
+}
+
+// scanTwoPeriods runs the interactive flow once and scans the same repositories over two date ranges
+func scanTwoPeriods() (*report.ScanSnapshot, *report.ScanSnapshot) {
+	cliTool := cli.NewCLI()
+	ctx := context.Background()
+	cfg := loadConfig()
+	bots := newBotMatcher(cfg)
+
+	selectedPlatform, platformClient := setupPlatformClient(ctx, cliTool, cfg)
+	scanMode := selectScanMode(cliTool)
+	repos := selectRepositories(ctx, cliTool, platformClient)
+
+	fmt.Println("\nStep 5: Chọn Khoảng Thời Gian")
+	fmt.Println("-" + strings.Repeat("-", 40) + "-")
+
+	fmt.Println("\n📅 Kỳ trước:")
+	baseStart, baseEnd, err := cliTool.PromptDateRange()
+	if err != nil {
+		fmt.Println("❌ Lỗi khi nhập ngày:", err)
+		os.Exit(1)
+	}
+
+	fmt.Println("\n📅 Kỳ này:")
+	headStart, headEnd, err := cliTool.PromptDateRange()
+	if err != nil {
+		fmt.Println("❌ Lỗi khi nhập ngày:", err)
+		os.Exit(1)
+	}
+
+	bugType := selectBugType(cliTool, scanMode)
+	opts := compareScanOptions(scanMode, bugType)
+
+	reporter := report.NewReporter()
+
+	base := runScan(ctx, platformClient, selectedPlatform, repos, baseStart, baseEnd, scanMode, bugType, opts, cfg, bots)
+	if err := reporter.ExportJSON("compare_base.json", base); err != nil {
+		fmt.Printf("❌ Lỗi khi export JSON: %v\n", err)
+	}
+
+	head := runScan(ctx, platformClient, selectedPlatform, repos, headStart, headEnd, scanMode, bugType, opts, cfg, bots)
+	if err := reporter.ExportJSON("compare_head.json", head); err != nil {
+		fmt.Printf("❌ Lỗi khi export JSON: %v\n", err)
+	}
+
+	return base, head
+}
+
+// runLabels implements the "labels" command: list the PR labels of the selected repositories
+// with their counts and whether the label taxonomy maps them to bug
+func runLabels() {
+	printHeader()
+
+	cliTool := cli.NewCLI()
+	ctx := context.Background()
+	cfg := loadConfig()
+
+	bugAnalyzer, err := analyzer.NewBugAnalyzerWithConfig(cfg.BugDetection)
+	if err != nil {
+		fmt.Println("❌ Cấu hình bug detection không hợp lệ:", err)
+		os.Exit(1)
+	}
+
+	_, platformClient := setupPlatformClient(ctx, cliTool, cfg)
+	repos := selectRepositories(ctx, cliTool, platformClient)
+
+	fmt.Println("\nChọn Khoảng Thời Gian")
+	fmt.Println("-" + strings.Repeat("-", 40) + "-")
+
+	startDate, endDate, err := cliTool.PromptDateRange()
+	if err != nil {
+		fmt.Println("❌ Lỗi khi nhập ngày:", err)
+		os.Exit(1)
+	}
+
+	maxWorkers := scanWorkers(len(repos))
+
+	scanJobs, err := platformClient.GetPullRequestsFromRepositoriesConcurrent(ctx, repos, startDate, endDate, maxWorkers)
+	if err != nil {
+		fmt.Printf("❌ Lỗi khi quét repositories: %v\n", err)
+	}
+
+	var prs []*platform.PullRequestData
+	for _, job := range scanJobs {
+		if job.Error != nil {
+			fmt.Printf("❌ Lỗi khi lấy PR từ %s/%s: %v\n", job.Owner, job.RepoName, job.Error)
+			continue
+		}
+		fmt.Printf("✓ %s/%s: %d PR\n", job.Owner, job.RepoName, len(job.PRData))
+		prs = append(prs, job.PRData...)
+	}
+
+	labels := report.BuildLabelStats(prs, bugAnalyzer)
+	if len(labels) == 0 {
+		fmt.Println("\n⚠️  Không có PR nào có label (Bitbucket và Backlog không hỗ trợ label trên PR)")
+		return
+	}
+
+	reporter := report.NewReporter()
+	reporter.PrintLabelStats(labels)
+	if err := reporter.ExportLabelStatsCSV("labels_report.csv", labels); err != nil {
+		fmt.Printf("❌ Lỗi khi export CSV: %v\n", err)
 	}
 
 	fmt.Println("\n✓ Hoàn thành!")
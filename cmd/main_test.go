@@ -0,0 +1,27 @@
+package main
+
+import "testing"
+
+func TestCompareScanOptions(t *testing.T) {
+	tests := []struct {
+		scanMode    string
+		bugType     string
+		wantReviews bool
+	}{
+		{"pr_rules", "", true},
+		{"bug", "bug_review", true},
+		{"bug", "combined", true},
+		{"bug", "bug", false},
+		{"bug", "title", false},
+	}
+
+	for _, tt := range tests {
+		opts := compareScanOptions(tt.scanMode, tt.bugType)
+		if opts.withReviews != tt.wantReviews {
+			t.Errorf("compareScanOptions(%q, %q).withReviews = %v, want %v", tt.scanMode, tt.bugType, opts.withReviews, tt.wantReviews)
+		}
+		if opts.withStats || opts.withFiles || opts.withBugIssues {
+			t.Errorf("compareScanOptions(%q, %q) = %+v, want only reviews", tt.scanMode, tt.bugType, opts)
+		}
+	}
+}
@@ -6,6 +6,7 @@ require (
 	github.com/gdamore/tcell/v2 v2.9.0
 	github.com/google/go-github/v56 v56.0.0
 	github.com/manifoldco/promptui v0.9.0
+	golang.org/x/text v0.28.0
 )
 
 require (
@@ -17,5 +18,4 @@ require (
 	github.com/rivo/uniseg v0.4.7 // indirect
 	golang.org/x/sys v0.35.0 // indirect
 	golang.org/x/term v0.34.0 // indirect
-	golang.org/x/text v0.28.0 // indirect
 )
@@ -1,8 +1,10 @@
 package analyzer
 
 import (
+	"fmt"
+	"path"
 	"regexp"
-	"strings"
+	"sort"
 
 	"github.com/bug-crawler/pkg/platform"
 )
@@ -36,27 +38,74 @@ const (
 
 // BugAnalyzer analyzes a PR to detect bug
 type BugAnalyzer struct {
-	bugLabelRegex *regexp.Regexp
+	labels         *labelTaxonomy
+	branchPatterns []*regexp.Regexp
+	titleTypes     map[string]bool
+	issueBugTypes  map[string]bool
+	// Project keys of the tracker issues referenced from PRs
+	issueProjectKeys []string
+	signalWeights    map[string]float64
+	threshold        float64         // Minimum confidence in combined mode
+	locales          *LocaleSelector // Locales of the "type: bug" marker per repository, English only if nil
+
+	severityLabels   labelClassifier
+	severityKeywords []keywordClassifier
+	categoryLabels   labelClassifier
+	categoryKeywords []keywordClassifier
 }
 
 // BugResult contains the result of analyzing a PR to detect bug
 type BugResult struct {
 	PR             *platform.PullRequestData
 	IsBugRelated   bool
-	DetectionType  string // "bug_review", "bug"
+	DetectionType  string // "bug_review", "description_regex", "label", "branch", "title", "linked_issue", "combined"
 	MatchedKeyword string
-	BugCount       int // Number of bugs from bug_review tag
+	BugCount       int            // Number of bugs from bug_review tags, summed over the description and review comments
+	BugsBySeverity map[string]int // bug_review breakdown per severity, summed over all tags
+	BugsByCategory map[string]int // bug_review breakdown per category, summed over all tags
+	BugsByReviewer map[string]int // Bugs of the bug_review tags in review comments, per reviewer
+	BugReviewTags  []BugReviewTag // Every bug_review tag found
+	Scope          string         // Conventional Commits scope of the PR title (component), empty if none
+	Signals        []BugSignal    // Every signal that fired, combined mode only
+	Confidence     float64        // Combined confidence of the signals (0-1), combined mode only
+	Severity       string         // critical, major or minor; empty if unknown (bug-related PRs only)
+	SeveritySource string         // ClassifiedByLabel, ClassifiedByBugReview or ClassifiedByKeyword
+	Category       string         // e.g. regression, ui, data, security; empty if unknown (bug-related PRs only)
+	CategorySource string         // ClassifiedByLabel, ClassifiedByBugReview or ClassifiedByKeyword
 }
 
 // NewBugAnalyzer initializes a BugAnalyzer
 func NewBugAnalyzer() *BugAnalyzer {
+	// The default patterns always compile
+	branchPatterns, _ := compileDetectionPatterns(DefaultBranchPatterns)
+	severityKeywords, _ := newKeywordClassifiers(DefaultSeverityKeywords, Severities)
+	categoryKeywords, _ := newKeywordClassifiers(DefaultCategoryKeywords, sortedClasses(DefaultCategoryKeywords))
+	labels, _ := newLabelTaxonomy(nil)
 	return &BugAnalyzer{
-		bugLabelRegex: regexp.MustCompile(`(?i:bug|fix|hotfix|critical|error|issue)`),
+		labels:           labels,
+		branchPatterns:   branchPatterns,
+		titleTypes:       titleTypeSet(DefaultTitleTypes),
+		issueBugTypes:    titleTypeSet(DefaultIssueBugTypes),
+		signalWeights:    DefaultSignalWeights,
+		threshold:        DefaultConfidenceThreshold,
+		severityLabels:   newLabelClassifier(DefaultSeverityLabels, Severities),
+		severityKeywords: severityKeywords,
+		categoryLabels:   newLabelClassifier(DefaultCategoryLabels, sortedClasses(DefaultCategoryLabels)),
+		categoryKeywords: categoryKeywords,
 	}
 }
 
-// AnalyzePR analyzes a PR to detect bug
+// AnalyzePR analyzes a PR to detect bug, then classifies the severity and category of bug-related PRs
 func (ba *BugAnalyzer) AnalyzePR(pr *platform.PullRequestData, bugType string, platformType string) *BugResult {
+	result := ba.detect(pr, bugType, platformType)
+	if result.IsBugRelated {
+		ba.classify(result)
+	}
+	return result
+}
+
+// detect checks a PR with the detection method of the bug type
+func (ba *BugAnalyzer) detect(pr *platform.PullRequestData, bugType string, platformType string) *BugResult {
 	result := &BugResult{
 		PR:            pr,
 		IsBugRelated:  false,
@@ -64,66 +113,74 @@ func (ba *BugAnalyzer) AnalyzePR(pr *platform.PullRequestData, bugType string, p
 		BugCount:      0,
 	}
 
-	descLower := strings.ToLower(pr.Description)
+	title, conventional := ParseConventionalTitle(pr.Title)
+	if conventional {
+		result.Scope = title.Scope
+	}
 
 	switch bugType {
 	case "bug_review":
-		// Check bug_review tag
-		bugCount, found := ba.extractBugReviewCount(descLower)
-		if found {
+		// Check bug_review tags in the description and review comments
+		applyBugReviewTags(result, bugReviewTags(pr))
+		if result.BugCount > 0 {
 			result.IsBugRelated = true
-			result.BugCount = bugCount
 			result.DetectionType = "bug_review"
 			result.MatchedKeyword = "bug_review"
 		}
 		return result
+	case "title":
+		// Conventional Commits title: fix(api): ..., hotfix: ..., revert: ...
+		if prefix, found := ba.matchTitle(title); found {
+			result.IsBugRelated = true
+			result.DetectionType = "title"
+			result.MatchedKeyword = prefix
+		}
+		return result
+	case "issue":
+		// Issue type or labels of the linked issues, resolved by the platform client
+		if issue, found := ba.matchLinkedIssue(pr); found {
+			result.IsBugRelated = true
+			result.DetectionType = "linked_issue"
+			result.MatchedKeyword = issue
+		}
+		return result
+	case "combined":
+		// Every signal is evaluated, the PR is counted once if their combined confidence reaches the threshold
+		signals, tags := ba.collectSignals(pr, platformType, title)
+		result.Signals = signals
+		result.Confidence = Confidence(signals)
+		if len(signals) > 0 && result.Confidence >= ba.threshold {
+			result.IsBugRelated = true
+			result.DetectionType = "combined"
+			result.MatchedKeyword = signalTypes(signals)
+			applyBugReviewTags(result, tags)
+		}
+		return result
 	default:
 		// For Bitbucket and Backlog, check description for "type: bug"
-		if platformType == "bitbucket" || platformType == "backlog" {
-			// Regex: type:\s*bug (case insensitive)
-			re := regexp.MustCompile(`(?i)type:\s*bug`)
-			if re.MatchString(pr.Description) {
-				result.IsBugRelated = true
-				result.DetectionType = "description_regex"
-				result.MatchedKeyword = "type: bug"
-				return result
-			}
+		if ba.matchTypeBug(pr, platformType) {
+			result.IsBugRelated = true
+			result.DetectionType = "description_regex"
+			result.MatchedKeyword = "type: bug"
+			return result
 		}
 
 		// Check labels: bug, fix, hotfix, critical, error, issue
-		for _, label := range pr.Labels {
-			if ba.bugLabelRegex.MatchString(label) {
-				result.IsBugRelated = true
-				result.DetectionType = "label"
-				result.MatchedKeyword = label
-				break
-			}
-		}
-		return result
-	}
-}
-
-// extractBugReviewCount extracts the bug count from the description
-func (ba *BugAnalyzer) extractBugReviewCount(desc string) (int, bool) {
-	// Find pattern: bug_review: <number>
-	re := regexp.MustCompile(`bug_review:\s*(\d+)`)
-	matches := re.FindStringSubmatch(desc)
-
-	if len(matches) >= 2 {
-		count := 0
-		// Parse number from string
-		for _, ch := range matches[1] {
-			if ch >= '0' && ch <= '9' {
-				count = count*10 + int(ch-'0')
-			}
+		if label, found := ba.matchLabel(pr); found {
+			result.IsBugRelated = true
+			result.DetectionType = "label"
+			result.MatchedKeyword = label
+			return result
 		}
 
-		if count > 0 {
-			return count, true
+		// Teams without labels name their branches fix/..., bugfix/..., hotfix/...
+		if branch, found := ba.matchBranch(pr); found {
+			result.IsBugRelated = true
+			result.DetectionType = "branch"
+			result.MatchedKeyword = branch
 		}
+		return result
 	}
-
-	return 0, false
 }
 
 // AnalyzePRs analyzes a list of PRs
@@ -150,91 +207,175 @@ func (ba *BugAnalyzer) GetBugCount(results []*BugResult) int {
 // PRRuleResult contains the result of analyzing a PR based on code review rules
 type PRRuleResult struct {
 	PR                 *platform.PullRequestData
-	PRDescriptionValid bool // PR description contains at least <MinKeywordsDescription> keywords
-	ReviewCommentValid bool // Review comment contains at least <MinKeywordsReviewComment> keywords
-	PRCompliant        bool // PR complies with all rules
+	PRDescriptionValid bool          // All description rule sets passed
+	ReviewCommentValid bool          // All review rule sets passed
+	ApprovalValid      bool          // All approval rule sets passed
+	ApprovalUnknown    bool          // Approval rule sets are configured but the platform does not report approvals
+	PRCompliant        bool          // PR complies with all rules
+	RuleResults        []*RuleResult // Result of every configured rule set
 }
 
 // PRRuleAnalyzer analyzes a PR based on code review rules
-type PRRuleAnalyzer struct{}
+type PRRuleAnalyzer struct {
+	ruleSets           []*compiledRuleSet            // Rule sets applied to every repository
+	repositoryRuleSets map[string][]*compiledRuleSet // Rule sets for repositories with overrides, keyed by pattern
+	repositoryPatterns []string                      // Override patterns in evaluation order
+	bots               *BotMatcher                   // Accounts ignored as reviewers
+	locales            *LocaleSelector               // Locales of the built-in keywords per repository, English only if nil
+	noApprovals        bool                          // The platform does not report approvals
+}
 
-// NewPRRuleAnalyzer creates a new PRRuleAnalyzer
+// NewPRRuleAnalyzer creates a new PRRuleAnalyzer using the default rules
 func NewPRRuleAnalyzer() *PRRuleAnalyzer {
-	return &PRRuleAnalyzer{}
+	// The default rules always compile
+	pra, _ := NewPRRuleAnalyzerWithConfig(DefaultRuleConfig())
+	return pra
 }
 
-// CheckKeywordsInText checks if the given text contains a sufficient number of specified keywords.
-// It uses regular expressions for specific keywords and falls back to substring matching for others.
-//
-// Parameters:
-//
-//	text: The input string to be analyzed (e.g., PR description).
-//	keywords: A slice of strings representing the keywords to look for.
-//
-// Returns:
-//
-//	bool: True if the number of matched keywords is greater than MinKeywordsDescription, false otherwise.
-func (pra *PRRuleAnalyzer) CheckKeywordsInText(text string, keywords []string) bool {
-	textLower := strings.ToLower(text)
-
-	// patterns defines regular expressions for specific keywords and their abbreviated forms.
-	// This allows for flexible matching beyond exact substring comparison.
-	patterns := map[string]*regexp.Regexp{
-		"Description":    regexp.MustCompile(`(?i:description|desc|d\d)`),
-		"Changes Made":   regexp.MustCompile(`(?i:changes made|changes|cm\d|change\d)`),
-		"Self-Review":    regexp.MustCompile(`(?i:self-review|self review|sr\d)`),
-		"Functionality":  regexp.MustCompile(`(?i:functionality|f\d)`),
-		"Security":       regexp.MustCompile(`(?i:security|s\d)`),
-		"Error Handling": regexp.MustCompile(`(?i:error handling|eh\d)`),
-		"Code Style":     regexp.MustCompile(`(?i:code style|readability|c\d)`),
-		"Dependencies":   regexp.MustCompile(`(?i:dependencies|dep\d)`),
+// NewPRRuleAnalyzerWithConfig creates a PRRuleAnalyzer evaluating the configured rule sets.
+// The default rule sets are used when the config does not define any.
+func NewPRRuleAnalyzerWithConfig(config *RuleConfig) (*PRRuleAnalyzer, error) {
+	if config == nil {
+		config = &RuleConfig{}
 	}
 
-	var matched []string
+	defaults := config.RuleSets
+	if len(defaults) == 0 {
+		defaults = DefaultRuleConfig().RuleSets
+	}
 
-	for _, keyword := range keywords {
-		if pattern, exists := patterns[keyword]; exists {
-			if pattern.MatchString(textLower) {
-				matched = append(matched, keyword)
-			}
-		} else {
-			// Fallback to substring matching if a specific regex pattern is not defined for the keyword.
-			keywordLower := strings.ToLower(keyword)
-			if strings.Contains(textLower, keywordLower) {
-				matched = append(matched, keyword)
-			}
+	ruleSets, err := compileRuleSets(mergeRuleSets(defaults, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	pra := &PRRuleAnalyzer{
+		ruleSets:           ruleSets,
+		repositoryRuleSets: make(map[string][]*compiledRuleSet),
+		bots:               DefaultBotMatcher,
+	}
+
+	for pattern, overrides := range config.Repositories {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("repository pattern không hợp lệ %q: %w", pattern, err)
+		}
+
+		repoRuleSets, err := compileRuleSets(mergeRuleSets(defaults, overrides))
+		if err != nil {
+			return nil, fmt.Errorf("repository %s: %w", pattern, err)
 		}
+		pra.repositoryRuleSets[pattern] = repoRuleSets
+		pra.repositoryPatterns = append(pra.repositoryPatterns, pattern)
 	}
+	sort.Strings(pra.repositoryPatterns)
 
-	// Determine if the number of found keywords meets the minimum requirement.
-	// MinKeywordsDescription is typically 3, meaning at greater than 2 keywords are required.
-	matchedCount := len(matched)
-	return matchedCount >= MinKeywordsDescription
+	return pra, nil
 }
 
-// AnalyzePRRule analyzes a PR based on code review rules
+// ruleSetsFor returns the rule sets applied to a repository.
+// An exact repository name wins over patterns; patterns are tried in alphabetical order.
+func (pra *PRRuleAnalyzer) ruleSetsFor(repository string) []*compiledRuleSet {
+	if ruleSets, exists := pra.repositoryRuleSets[repository]; exists {
+		return ruleSets
+	}
+	for _, pattern := range pra.repositoryPatterns {
+		if matchRepository(pattern, repository) {
+			return pra.repositoryRuleSets[pattern]
+		}
+	}
+	return pra.ruleSets
+}
+
+// SetLocales selects the locales whose built-in keywords are checked for each repository
+func (pra *PRRuleAnalyzer) SetLocales(locales *LocaleSelector) {
+	pra.locales = locales
+}
+
+// SetBots sets the accounts ignored as reviewers (DefaultBotMatcher if nil)
+func (pra *PRRuleAnalyzer) SetBots(bots *BotMatcher) {
+	if bots == nil {
+		bots = DefaultBotMatcher
+	}
+	pra.bots = bots
+}
+
+// SetApprovalsAvailable tells whether the platform reports approvals.
+// Without approvals, approval rule sets are reported as unavailable instead of failing every PR.
+func (pra *PRRuleAnalyzer) SetApprovalsAvailable(available bool) {
+	pra.noApprovals = !available
+}
+
+// CheckKeywordsInText checks that a PR description has at least MinKeywordsDescription of the given
+// template sections. The text is parsed as Markdown: a keyword only counts as a heading ("## Security",
+// "## S1") whose section has real (non-placeholder) content, so "sha256" in a sentence is not a Security section.
+//
+// Returns:
+//
+//	*KeywordCheckResult: Valid is true if at least MinKeywordsDescription sections were found.
+//	Matched lists every found section with its heading, Missing lists the others.
+func (pra *PRRuleAnalyzer) CheckKeywordsInText(text string, keywords []string) *KeywordCheckResult {
+	sectionSet := keywordRuleSet("sections", RuleTargetDescription, keywords, descriptionKeywordPatterns, MinKeywordsDescription)
+	sectionSet.Match = RuleMatchSections
+
+	// The built-in description patterns always compile
+	ruleSet, _ := compileRuleSet(sectionSet)
+	return keywordCheckResult(ruleSet.evaluate(text))
+}
+
+// AnalyzePRRule analyzes a PR based on the rule sets configured for its repository
 func (pra *PRRuleAnalyzer) AnalyzePRRule(pr *platform.PullRequestData) *PRRuleResult {
 	result := &PRRuleResult{
 		PR:                 pr,
-		PRDescriptionValid: false,
-		ReviewCommentValid: false,
+		PRDescriptionValid: true,
+		ReviewCommentValid: true,
+		ApprovalValid:      true,
 		PRCompliant:        false,
+		RuleResults:        make([]*RuleResult, 0),
 	}
 
-	// Check if PR description contains at least MinKeywordsDescription keywords
-	result.PRDescriptionValid = pra.CheckKeywordsInText(pr.Description, DescriptionKeywords)
-
-	// Check if review comments contain at least MinKeywordsReviewComment keywords
-	result.ReviewCommentValid = pra.CheckReviewComments(pr.Reviews)
+	locales := pra.locales.For(pr.Repository)
+	for _, ruleSet := range pra.ruleSetsFor(pr.Repository) {
+		ruleSet = ruleSet.localized(locales)
+		switch ruleSet.Target {
+		case RuleTargetDescription:
+			ruleResult := ruleSet.evaluate(pr.Description)
+			result.PRDescriptionValid = result.PRDescriptionValid && ruleResult.Passed
+			result.RuleResults = append(result.RuleResults, ruleResult)
+		case RuleTargetReview:
+			ruleResult := ruleSet.evaluateReviewers(pr.Reviews, pr.Author, pra.bots)
+			result.ReviewCommentValid = result.ReviewCommentValid && ruleResult.Passed
+			result.RuleResults = append(result.RuleResults, ruleResult)
+		case RuleTargetApproval:
+			if pra.noApprovals {
+				result.ApprovalUnknown = true
+				result.RuleResults = append(result.RuleResults, ruleSet.approvalUnavailable())
+				continue
+			}
+			ruleResult := ruleSet.evaluateApproval(pr)
+			result.ApprovalValid = result.ApprovalValid && ruleResult.Passed
+			result.RuleResults = append(result.RuleResults, ruleResult)
+		}
+	}
 
 	// Determine if the PR complies with all rules
-	result.PRCompliant = result.PRDescriptionValid && result.ReviewCommentValid
+	result.PRCompliant = result.PRDescriptionValid && result.ReviewCommentValid && result.ApprovalValid
 
 	return result
 }
 
-// CheckReviewComments analyzes the provided review comments to ensure they contain a sufficient number of predefined keywords.
-// It aggregates all comments from reviewers and checks for the presence of keywords,
+// aggregateReviewComments concatenates all comments of the given reviews
+func aggregateReviewComments(reviews []*platform.ReviewData) string {
+	allComments := ""
+	for _, review := range reviews {
+		if review.CommentBody != "" {
+			allComments += " " + review.CommentBody
+		}
+	}
+	return allComments
+}
+
+// CheckReviewComments analyzes the provided review comments to ensure they satisfy the review rule sets.
+// Comments are grouped per reviewer (bots excluded) and each reviewer is checked for the presence of keywords,
 // supporting both direct substring matching and regular expression patterns for keywords and their abbreviated tags.
 //
 // Parameters:
@@ -243,55 +384,19 @@ func (pra *PRRuleAnalyzer) AnalyzePRRule(pr *platform.PullRequestData) *PRRuleRe
 //
 // Returns:
 //
-//	bool: True if the aggregated review comments contain more than `MinKeywordsReviewComment` (typically 3, meaning at more than 3)
-//	      of the `ReviewCommentKeywords`, indicating compliance. False otherwise.
-func (pra *PRRuleAnalyzer) CheckReviewComments(reviews []*platform.ReviewData) bool {
-	if len(reviews) == 0 {
-		return false
-	}
-
-	// Aggregate all comments from reviewers
-	allComments := ""
-	for _, review := range reviews {
-		if review.CommentBody != "" {
-			allComments += " " + review.CommentBody
-		}
-	}
-
-	if allComments == "" {
-		return false
-	}
-
-	textLower := strings.ToLower(allComments)
-
-	var matched []string
-
-	// Regex patterns to match keywords and abbreviated tags for review comments
-	patterns := map[string]*regexp.Regexp{
-		"Functionality":    regexp.MustCompile(`(?i:functionality|f\d)`),
-		"Security":         regexp.MustCompile(`(?i:security|s\d)`),
-		"Error Handling":   regexp.MustCompile(`(?i:error handling|eh\d)`),
-		"Code Style":       regexp.MustCompile(`(?i:code style|c\d)`),
-		"Code Readability": regexp.MustCompile(`(?i:readability|code readability|cr\d)`),
-	}
-
-	for _, keyword := range ReviewCommentKeywords {
-		if pattern, exists := patterns[keyword]; exists {
-			if pattern.MatchString(textLower) {
-				matched = append(matched, keyword)
-			}
-		} else {
-			// Fallback to substring matching if pattern not defined
-			keywordLower := strings.ToLower(keyword)
-			if strings.Contains(textLower, keywordLower) {
-				matched = append(matched, keyword)
-			}
+//	*KeywordCheckResult: Valid is true if every review rule set passes for its reviewer mode
+//	(by default: one reviewer mentions at least `MinKeywordsReviewComment` of the `ReviewCommentKeywords`).
+//	Matched and Missing explain which keywords were found (with their span) and which no reviewer mentioned.
+func (pra *PRRuleAnalyzer) CheckReviewComments(reviews []*platform.ReviewData) *KeywordCheckResult {
+	var ruleResults []*RuleResult
+	locales := pra.locales.For("")
+	for _, ruleSet := range pra.ruleSets {
+		if ruleSet.Target == RuleTargetReview {
+			ruleResults = append(ruleResults, ruleSet.localized(locales).evaluateReviewers(reviews, "", pra.bots))
 		}
 	}
 
-	// Allow as long as > MinKeywordsReviewComment keywords are found
-	matchedCount := len(matched)
-	return matchedCount >= MinKeywordsReviewComment
+	return keywordCheckResult(ruleResults...)
 }
 
 // AnalyzePRRules analyzes a list of PRs based on code review rules
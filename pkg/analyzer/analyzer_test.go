@@ -659,8 +659,8 @@ func TestNewBugAnalyzer(t *testing.T) {
 		t.Fatal("NewBugAnalyzer returned nil")
 	}
 
-	if analyzer.bugLabelRegex == nil {
-		t.Error("bugLabelRegex should not be nil")
+	if analyzer.labels == nil {
+		t.Error("labels should not be nil")
 	}
 }
 
@@ -670,122 +670,72 @@ func TestCheckKeywordsInText(t *testing.T) {
 	analyzer := NewPRRuleAnalyzer()
 
 	tests := []struct {
-		name      string
-		text      string
-		keywords  []string
-		wantValid bool
+		name        string
+		text        string
+		keywords    []string
+		wantValid   bool
+		wantMissing []string
 	}{
 		{
-			name:      "All keywords present (7/7)",
-			text:      "Description of the work. Changes Made to the code. Self-Review checklist completed. Functionality is working. Security is handled. Error Handling is in place. Code Style follows conventions. Dependencies are managed.",
-			keywords:  DescriptionKeywords,
-			wantValid: true,
-		},
-		{
-			name:      "6 keywords present (>2 required)",
-			text:      "Description of the work. Changes Made to the code. Self-Review checklist. Functionality works. Security is good. Error Handling included. Dependencies managed.",
+			name:      "All sections present (8/8)",
+			text:      "## Description\nThe work.\n## Changes Made\nThe code.\n## Self-Review\nChecklist completed.\n## Functionality\nWorking.\n## Security\nHandled.\n## Error Handling\nIn place.\n## Code Style\nFollows conventions.\n## Dependencies\nManaged.",
 			keywords:  DescriptionKeywords,
 			wantValid: true,
 		},
 		{
-			name:      "Exactly 3 keywords (minimum required, >2)",
-			text:      "Description provided. Changes Made documented. Functionality tested.",
+			name:      "Exactly 3 sections (minimum required, >2)",
+			text:      "## Description\nProvided.\n## Changes Made\nDocumented.\n## Functionality\nTested.",
 			keywords:  DescriptionKeywords,
 			wantValid: true,
 		},
 		{
-			name:      "Only 2 keywords (should fail, need >2)",
-			text:      "Description provided. Changes Made documented.",
-			keywords:  DescriptionKeywords,
-			wantValid: false,
-		},
-		{
-			name:      "Only 1 keyword (should fail)",
-			text:      "Description of the work.",
+			name:      "Only 2 sections (should fail, need >2)",
+			text:      "## Description\nProvided.\n## Changes Made\nDocumented.",
 			keywords:  DescriptionKeywords,
 			wantValid: false,
 		},
 		{
-			name:      "Case insensitive matching (7/7)",
-			text:      "DESCRIPTION of work. CHANGES MADE to files. SELF-REVIEW done. FUNCTIONALITY tested. SECURITY checked. ERROR HANDLING implemented. CODE STYLE followed. DEPENDENCIES updated.",
+			name:      "Case insensitive headings",
+			text:      "## DESCRIPTION\nWork.\n## changes made\nFiles.\n## Self-review\nDone.",
 			keywords:  DescriptionKeywords,
 			wantValid: true,
 		},
 		{
-			name:      "Mixed case keywords (7/7)",
-			text:      "description here. Changes Made included. self-review completed. Functionality present. Security evaluated. error handling done. Code Style checked. Dependencies reviewed.",
+			name:      "Abbreviated headings (D1, CM1, S1)",
+			text:      "## D1\nAdd 2FA\n## CM1: handler\nUpdated\n## S1\nOK\n",
 			keywords:  DescriptionKeywords,
 			wantValid: true,
 		},
 		{
-			name:      "Review comment all 5 keywords (>2 required)",
-			text:      "Reviewed the functionality carefully. Security looks good. Error Handling is comprehensive. Code Style is excellent. Code Readability is clear.",
-			keywords:  ReviewCommentKeywords,
-			wantValid: true,
-		},
-		{
-			name:      "Review comment 4 keywords (>2 required)",
-			text:      "Reviewed the functionality carefully. Security looks good. Error Handling is comprehensive. Code Style is excellent.",
-			keywords:  ReviewCommentKeywords,
-			wantValid: true,
-		},
-		{
-			name:      "Review comment exactly 3 keywords (minimum)",
-			text:      "Reviewed the functionality carefully. Security looks good. Error Handling is comprehensive.",
-			keywords:  ReviewCommentKeywords,
-			wantValid: true,
-		},
-		{
-			name:      "Review comment only 2 keywords (should fail)",
-			text:      "Reviewed the functionality carefully. Security looks good.",
-			keywords:  ReviewCommentKeywords,
-			wantValid: false,
+			name:        "Keywords in sentences are not sections",
+			text:        "This addresses: Description, Changes Made, Self-Review, Functionality, Security, Error Handling, Code Style, Dependencies.",
+			keywords:    []string{"Description", "Changes Made", "Security", "Code Style"},
+			wantValid:   false,
+			wantMissing: []string{"Description", "Changes Made", "Security", "Code Style"},
 		},
 		{
-			name:      "Review comment only 1 keyword (should fail)",
-			text:      "Reviewed the functionality carefully.",
-			keywords:  ReviewCommentKeywords,
-			wantValid: false,
+			name:        "Abbreviations inside text are not sections",
+			text:        "Update sha256 checksum and h264 decoder, d1 c2 s3",
+			keywords:    []string{"Description", "Changes Made", "Security", "Code Style"},
+			wantValid:   false,
+			wantMissing: []string{"Description", "Changes Made", "Security", "Code Style"},
 		},
 		{
-			name:      "Empty text (0 keywords)",
-			text:      "",
-			keywords:  ReviewCommentKeywords,
-			wantValid: false,
+			name:        "Empty and placeholder sections",
+			text:        "## Description\nAdd 2FA\n## Changes Made\n- [Thay đổi 1]\n## Security\n\n## Code Style\n- Ran gofmt\n",
+			keywords:    []string{"Description", "Changes Made", "Security", "Code Style"},
+			wantValid:   false,
+			wantMissing: []string{"Changes Made", "Security"},
 		},
 		{
-			name:      "Keywords with special characters (7/7)",
-			text:      "This addresses: Description, Changes Made, Self-Review, Functionality, Security, Error Handling, Code Style, Dependencies.",
-			keywords:  DescriptionKeywords,
-			wantValid: true,
-		},
-		{
-			name:      "Abbreviated tags (D1, CM1, SR1, F1, S1, EH1, C1)",
-			text:      "D1: Feature overview. CM1: Updated code. SR1: Self checked. F1: Works well. S1: Secure. EH1: Error handled. C1: Code formatted. DEP1: Deps updated.",
-			keywords:  DescriptionKeywords,
-			wantValid: true,
-		},
-		{
-			name:      "Mixed full and abbreviated keywords (7/7)",
-			text:      "Description provided. CM1: Updated. Self-Review done. F1: Working. Security verified. Error Handling solid. Code Style good. Dependencies managed.",
-			keywords:  DescriptionKeywords,
+			name:      "Emoji and numbered headings",
+			text:      "## 📝 Description\nAdd 2FA\n## 1. Changes Made\n- Add middleware\n## Security\n- Encrypt secrets\n",
+			keywords:  []string{"Description", "Changes Made", "Security", "Code Style"},
 			wantValid: true,
 		},
 		{
-			name:      "Partial keyword match only (should fail - need real keywords)",
-			text:      "Describe the change. Change made. Self. Function. Secure. Error. Code.",
-			keywords:  DescriptionKeywords,
-			wantValid: false,
-		},
-		{
-			name:      "Real-world PR description with 3+ keywords",
-			text:      "Description: Fixed login bug. Changes Made: Updated auth logic. Self-Review: Tested locally. Functionality: Works correctly.",
-			keywords:  DescriptionKeywords,
-			wantValid: true,
-		},
-		{
-			name:      "Real-world minimal PR (only 2 keywords)",
-			text:      "Description: Update version. Changes Made: Bumped to 1.0.7.",
+			name:      "Empty text",
+			text:      "",
 			keywords:  DescriptionKeywords,
 			wantValid: false,
 		},
@@ -793,12 +743,15 @@ func TestCheckKeywordsInText(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			valid := analyzer.CheckKeywordsInText(tt.text, tt.keywords)
+			result := analyzer.CheckKeywordsInText(tt.text, tt.keywords)
 
-			if valid != tt.wantValid {
-				t.Errorf("CheckKeywordsInText() valid = %v, want %v", valid, tt.wantValid)
+			if result.Valid != tt.wantValid {
+				t.Errorf("CheckKeywordsInText() valid = %v, want %v (matched %+v)", result.Valid, tt.wantValid, result.Matched)
 				t.Logf("Text: %q", tt.text)
 			}
+			if tt.wantMissing != nil && !equalStrings(result.Missing, tt.wantMissing) {
+				t.Errorf("Missing = %v, want %v", result.Missing, tt.wantMissing)
+			}
 		})
 	}
 }
@@ -845,6 +798,7 @@ func TestCheckReviewComments(t *testing.T) {
 			wantValid: true,
 		},
 		{
+			// Comments are no longer combined: each reviewer is checked separately
 			name: "Multiple reviewers with combined 3+ keywords",
 			reviews: []*platform.ReviewData{
 				{
@@ -858,7 +812,7 @@ func TestCheckReviewComments(t *testing.T) {
 					CommentBody:   "Security is good. Error Handling is solid.",
 				},
 			},
-			wantValid: true,
+			wantValid: false,
 		},
 		{
 			name: "Only 2 keywords (below minimum)",
@@ -943,7 +897,7 @@ func TestCheckReviewComments(t *testing.T) {
 			wantValid: true,
 		},
 		{
-			name: "Multiple reviews - combined to reach minimum",
+			name: "Multiple reviews - each reviewer below minimum",
 			reviews: []*platform.ReviewData{
 				{
 					ReviewerLogin: "reviewer1",
@@ -961,13 +915,13 @@ func TestCheckReviewComments(t *testing.T) {
 					CommentBody:   "Error Handling is solid.",
 				},
 			},
-			wantValid: true,
+			wantValid: false,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			valid := analyzer.CheckReviewComments(tt.reviews)
+			valid := analyzer.CheckReviewComments(tt.reviews).Valid
 
 			if valid != tt.wantValid {
 				t.Errorf("CheckReviewComments() valid = %v, want %v", valid, tt.wantValid)
@@ -991,7 +945,7 @@ func TestAnalyzePRRule(t *testing.T) {
 			pr: &platform.PullRequestData{
 				Number:      1,
 				Title:       "Add feature",
-				Description: "Description: Feature overview. Changes Made: Added new component. Self-Review: Tested manually. Functionality: Works as expected. Security: No issues. Error Handling: Try-catch implemented. Code Style: Follows conventions. Dependencies: Updated.",
+				Description: "## Description\nFeature overview\n## Changes Made\nAdded new component\n## Self-Review\nTested manually\n## Functionality\nWorks as expected\n## Security\nNo issues\n## Error Handling\nTry-catch implemented\n## Code Style\nFollows conventions\n## Dependencies\nUpdated",
 				Reviews: []*platform.ReviewData{
 					{
 						ReviewerLogin: "reviewer1",
@@ -1029,7 +983,7 @@ func TestAnalyzePRRule(t *testing.T) {
 			pr: &platform.PullRequestData{
 				Number:      3,
 				Title:       "Refactor code",
-				Description: "Description: Refactoring. Changes Made: Updated logic. Self-Review: Checked. Functionality: Tested. Security: Verified. Error Handling: Handled. Code Style: Formatted. Dependencies: Updated.",
+				Description: "## Description\nRefactoring\n## Changes Made\nUpdated logic\n## Self-Review\nChecked\n## Functionality\nTested\n## Security\nVerified\n## Error Handling\nHandled\n## Code Style\nFormatted\n## Dependencies\nUpdated",
 				Reviews: []*platform.ReviewData{
 					{
 						ReviewerLogin: "reviewer1",
@@ -1067,7 +1021,7 @@ func TestAnalyzePRRule(t *testing.T) {
 			pr: &platform.PullRequestData{
 				Number:      5,
 				Title:       "Add feature",
-				Description: "Description: New feature. Changes Made: Files added. Self-Review: Done. Functionality: Tested. Security: Checked. Error Handling: Implemented. Code Style: Verified. Dependencies: Updated.",
+				Description: "## Description\nNew feature\n## Changes Made\nFiles added\n## Self-Review\nDone\n## Functionality\nTested\n## Security\nChecked\n## Error Handling\nImplemented\n## Code Style\nVerified\n## Dependencies\nUpdated",
 				Reviews:     []*platform.ReviewData{},
 				HTMLURL:     "https://github.com/test/pull/5",
 			},
@@ -1096,3 +1050,84 @@ func TestAnalyzePRRule(t *testing.T) {
 		})
 	}
 }
+
+func TestAnalyzePR_Branch(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      *BugDetectionConfig
+		pr          *platform.PullRequestData
+		wantBug     bool
+		wantType    string
+		wantKeyword string
+	}{
+		{
+			name:        "fix branch",
+			pr:          &platform.PullRequestData{SourceBranch: "fix/login-crash", TargetBranch: "main"},
+			wantBug:     true,
+			wantType:    "branch",
+			wantKeyword: "fix/login-crash",
+		},
+		{
+			name:        "hotfix branch, case-insensitive",
+			pr:          &platform.PullRequestData{SourceBranch: "Hotfix-1.2.3"},
+			wantBug:     true,
+			wantType:    "branch",
+			wantKeyword: "Hotfix-1.2.3",
+		},
+		{
+			name:    "feature branch",
+			pr:      &platform.PullRequestData{SourceBranch: "feature/prefix-fix"},
+			wantBug: false,
+		},
+		{
+			name:    "fix word inside branch name",
+			pr:      &platform.PullRequestData{SourceBranch: "fixture-update"},
+			wantBug: false,
+		},
+		{
+			name:        "label wins over branch",
+			pr:          &platform.PullRequestData{SourceBranch: "bugfix/x", Labels: []string{"bug"}},
+			wantBug:     true,
+			wantType:    "label",
+			wantKeyword: "bug",
+		},
+		{
+			name:        "custom patterns",
+			config:      &BugDetectionConfig{BranchPatterns: []string{`^defect/`, `/bug-\d+$`}},
+			pr:          &platform.PullRequestData{SourceBranch: "team-a/bug-42"},
+			wantBug:     true,
+			wantType:    "branch",
+			wantKeyword: "team-a/bug-42",
+		},
+		{
+			name:    "custom patterns replace defaults",
+			config:  &BugDetectionConfig{BranchPatterns: []string{`^defect/`}},
+			pr:      &platform.PullRequestData{SourceBranch: "fix/x"},
+			wantBug: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			analyzer, err := NewBugAnalyzerWithConfig(tt.config)
+			if err != nil {
+				t.Fatalf("NewBugAnalyzerWithConfig() error = %v", err)
+			}
+
+			result := analyzer.AnalyzePR(tt.pr, "bug", "bitbucket")
+			if result.IsBugRelated != tt.wantBug {
+				t.Errorf("IsBugRelated = %v, want %v", result.IsBugRelated, tt.wantBug)
+			}
+			if result.DetectionType != tt.wantType {
+				t.Errorf("DetectionType = %q, want %q", result.DetectionType, tt.wantType)
+			}
+			if result.MatchedKeyword != tt.wantKeyword {
+				t.Errorf("MatchedKeyword = %q, want %q", result.MatchedKeyword, tt.wantKeyword)
+			}
+		})
+	}
+
+	if _, err := NewBugAnalyzerWithConfig(&BugDetectionConfig{BranchPatterns: []string{"("}}); err == nil {
+		t.Error("Expected error for invalid branch pattern")
+	}
+}
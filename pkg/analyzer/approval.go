@@ -0,0 +1,107 @@
+package analyzer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bug-crawler/pkg/platform"
+)
+
+// DefaultApprovalRuleSet is the name of the built-in approval rule set
+const DefaultApprovalRuleSet = "approval"
+
+// Review states as reported by GitHub; Bitbucket and Backlog approvals are mapped to them
+const (
+	ReviewStateApproved         = "APPROVED"
+	ReviewStateChangesRequested = "CHANGES_REQUESTED"
+	ReviewStateCommented        = "COMMENTED"
+	ReviewStateDismissed        = "DISMISSED"
+)
+
+// ApprovalResult contains the approval status of a PR
+type ApprovalResult struct {
+	Approvers          []string // Reviewers other than the author whose latest review approves the PR
+	ChangesRequestedBy []string // Reviewers other than the author whose latest review requests changes
+	Unavailable        bool     // The platform does not report approvals; the rule set is neither passed nor failed
+}
+
+// LatestReviewStates returns the latest approving, change-requesting or dismissed review state of each reviewer.
+// Reviews are expected in chronological order; comments don't change a reviewer's state.
+// Reviewers are returned in order of their first review.
+func LatestReviewStates(pr *platform.PullRequestData) ([]string, map[string]string) {
+	var reviewers []string
+	states := make(map[string]string)
+
+	for _, review := range pr.Reviews {
+		if review.ReviewerLogin == "" || strings.EqualFold(review.ReviewerLogin, pr.Author) {
+			continue
+		}
+		switch review.State {
+		case ReviewStateApproved, ReviewStateChangesRequested, ReviewStateDismissed:
+			if _, exists := states[review.ReviewerLogin]; !exists {
+				reviewers = append(reviewers, review.ReviewerLogin)
+			}
+			states[review.ReviewerLogin] = review.State
+		}
+	}
+
+	return reviewers, states
+}
+
+// approvalUnavailable returns the result of an approval rule set on a platform without approvals.
+// It counts as passed so that PR compliance only depends on the rules that can be checked.
+func (rs *compiledRuleSet) approvalUnavailable() *RuleResult {
+	return &RuleResult{
+		Name:       rs.Name,
+		Target:     rs.Target,
+		Passed:     true,
+		MinMatches: rs.MinApprovals,
+		Matched:    make([]KeywordMatch, 0),
+		Missing:    make([]string, 0),
+		Empty:      make([]string, 0),
+		Approval: &ApprovalResult{
+			Approvers:          make([]string, 0),
+			ChangesRequestedBy: make([]string, 0),
+			Unavailable:        true,
+		},
+	}
+}
+
+// evaluateApproval checks the approval rule set against the reviews of a PR
+func (rs *compiledRuleSet) evaluateApproval(pr *platform.PullRequestData) *RuleResult {
+	result := &RuleResult{
+		Name:       rs.Name,
+		Target:     rs.Target,
+		MinMatches: rs.MinApprovals,
+		Matched:    make([]KeywordMatch, 0),
+		Missing:    make([]string, 0),
+		Empty:      make([]string, 0),
+		Approval: &ApprovalResult{
+			Approvers:          make([]string, 0),
+			ChangesRequestedBy: make([]string, 0),
+		},
+	}
+
+	reviewers, states := LatestReviewStates(pr)
+	for _, reviewer := range reviewers {
+		switch states[reviewer] {
+		case ReviewStateApproved:
+			result.Approval.Approvers = append(result.Approval.Approvers, reviewer)
+			result.Matched = append(result.Matched, KeywordMatch{Keyword: "Approval", Span: reviewer})
+		case ReviewStateChangesRequested:
+			result.Approval.ChangesRequestedBy = append(result.Approval.ChangesRequestedBy, reviewer)
+		}
+	}
+	result.MatchedCount = len(result.Approval.Approvers)
+
+	result.Passed = result.MatchedCount >= rs.MinApprovals
+	if !result.Passed {
+		result.Missing = append(result.Missing, fmt.Sprintf("Approval (%d/%d)", result.MatchedCount, rs.MinApprovals))
+	}
+	if rs.BlockChangesRequested && len(result.Approval.ChangesRequestedBy) > 0 {
+		result.Passed = false
+		result.Missing = append(result.Missing, fmt.Sprintf("Changes requested (%s)", strings.Join(result.Approval.ChangesRequestedBy, ", ")))
+	}
+
+	return result
+}
@@ -0,0 +1,114 @@
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/bug-crawler/pkg/platform"
+)
+
+func TestAnalyzePRRule_Approval(t *testing.T) {
+	approvalRule := func(minApprovals int, blockChangesRequested bool) *RuleConfig {
+		return &RuleConfig{RuleSets: []RuleSet{{
+			Name:                  "approval",
+			Target:                RuleTargetApproval,
+			MinApprovals:          minApprovals,
+			BlockChangesRequested: blockChangesRequested,
+		}}}
+	}
+
+	tests := []struct {
+		name          string
+		config        *RuleConfig
+		reviews       []*platform.ReviewData
+		wantValid     bool
+		wantApprovers []string
+		wantMissing   []string
+	}{
+		{
+			name:          "one approval",
+			config:        approvalRule(1, false),
+			reviews:       []*platform.ReviewData{{ReviewerLogin: "r1", State: ReviewStateApproved}},
+			wantValid:     true,
+			wantApprovers: []string{"r1"},
+		},
+		{
+			name:   "author approval does not count",
+			config: approvalRule(1, false),
+			reviews: []*platform.ReviewData{
+				{ReviewerLogin: "Author", State: ReviewStateApproved},
+				{ReviewerLogin: "r1", State: ReviewStateCommented},
+			},
+			wantValid:     false,
+			wantApprovers: []string{},
+			wantMissing:   []string{"Approval (0/1)"},
+		},
+		{
+			name:   "latest state wins",
+			config: approvalRule(2, false),
+			reviews: []*platform.ReviewData{
+				{ReviewerLogin: "r1", State: ReviewStateApproved},
+				{ReviewerLogin: "r2", State: ReviewStateChangesRequested},
+				{ReviewerLogin: "r2", State: ReviewStateApproved},
+				{ReviewerLogin: "r1", State: ReviewStateDismissed},
+				{ReviewerLogin: "r1", State: ReviewStateCommented},
+			},
+			wantValid:     false,
+			wantApprovers: []string{"r2"},
+			wantMissing:   []string{"Approval (1/2)"},
+		},
+		{
+			name:   "outstanding change request",
+			config: approvalRule(1, true),
+			reviews: []*platform.ReviewData{
+				{ReviewerLogin: "r1", State: ReviewStateApproved},
+				{ReviewerLogin: "r2", State: ReviewStateChangesRequested},
+			},
+			wantValid:     false,
+			wantApprovers: []string{"r1"},
+			wantMissing:   []string{"Changes requested (r2)"},
+		},
+		{
+			name:   "change request ignored when not blocking",
+			config: approvalRule(1, false),
+			reviews: []*platform.ReviewData{
+				{ReviewerLogin: "r1", State: ReviewStateApproved},
+				{ReviewerLogin: "r2", State: ReviewStateChangesRequested},
+			},
+			wantValid:     true,
+			wantApprovers: []string{"r1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			analyzer, err := NewPRRuleAnalyzerWithConfig(tt.config)
+			if err != nil {
+				t.Fatalf("NewPRRuleAnalyzerWithConfig failed: %v", err)
+			}
+
+			result := analyzer.AnalyzePRRule(&platform.PullRequestData{Author: "author", Reviews: tt.reviews})
+
+			if result.ApprovalValid != tt.wantValid {
+				t.Errorf("ApprovalValid = %v, want %v", result.ApprovalValid, tt.wantValid)
+			}
+			if result.PRCompliant != tt.wantValid {
+				t.Errorf("PRCompliant = %v, want %v", result.PRCompliant, tt.wantValid)
+			}
+
+			ruleResult := result.RuleResults[0]
+			if !equalStrings(ruleResult.Approval.Approvers, tt.wantApprovers) {
+				t.Errorf("Approvers = %v, want %v", ruleResult.Approval.Approvers, tt.wantApprovers)
+			}
+			if tt.wantMissing != nil && !equalStrings(ruleResult.Missing, tt.wantMissing) {
+				t.Errorf("Missing = %v, want %v", ruleResult.Missing, tt.wantMissing)
+			}
+		})
+	}
+}
+
+func TestNewPRRuleAnalyzerWithConfig_InvalidApproval(t *testing.T) {
+	config := &RuleConfig{RuleSets: []RuleSet{{Name: "approval", Target: RuleTargetApproval, MinApprovals: -1}}}
+	if _, err := NewPRRuleAnalyzerWithConfig(config); err == nil {
+		t.Error("Expected error for negative min_approvals")
+	}
+}
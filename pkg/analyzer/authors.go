@@ -0,0 +1,93 @@
+package analyzer
+
+import (
+	"fmt"
+
+	"github.com/bug-crawler/pkg/platform"
+)
+
+// Reasons a PR is excluded by the author filter
+const (
+	ExcludedBot         = "bot"          // Author is a bot or service account
+	ExcludedByExclude   = "exclude"      // Author matches an exclude pattern
+	ExcludedNotIncluded = "not_included" // Include patterns are set and the author matches none
+)
+
+// AuthorFilterConfig configures which PR authors are analyzed.
+// Patterns are exact logins or names ("alice"), globs with "*" and "?" ("ci-*")
+// or regexes between slashes ("/^release-\d+$/"), all case-insensitive.
+type AuthorFilterConfig struct {
+	Include     []string `json:"include,omitempty"`      // If set, only PRs of matching authors are analyzed
+	Exclude     []string `json:"exclude,omitempty"`      // PRs of matching authors are left out
+	ExcludeBots *bool    `json:"exclude_bots,omitempty"` // Leave out PRs of bots; true if not set
+	// Bot patterns, also ignored as reviewers and in lead times; DefaultBotPatterns if not set
+	Bots []string `json:"bots,omitempty"`
+}
+
+// AuthorFilter decides which PRs are analyzed based on their author
+type AuthorFilter struct {
+	include     []accountPattern
+	exclude     []accountPattern
+	bots        *BotMatcher
+	excludeBots bool
+}
+
+// NewAuthorFilter initializes an AuthorFilter from the config (bots excluded if nil).
+// Bots are recognized by the given matcher, compiled from cfg.Bots (DefaultBotMatcher if nil).
+func NewAuthorFilter(cfg *AuthorFilterConfig, bots *BotMatcher) (*AuthorFilter, error) {
+	if cfg == nil {
+		cfg = &AuthorFilterConfig{}
+	}
+	if bots == nil {
+		bots = DefaultBotMatcher
+	}
+
+	af := &AuthorFilter{excludeBots: cfg.ExcludeBots == nil || *cfg.ExcludeBots, bots: bots}
+
+	var err error
+	if af.include, err = compileAccountPatterns(cfg.Include); err != nil {
+		return nil, fmt.Errorf("authors.include: %w", err)
+	}
+	if af.exclude, err = compileAccountPatterns(cfg.Exclude); err != nil {
+		return nil, fmt.Errorf("authors.exclude: %w", err)
+	}
+
+	return af, nil
+}
+
+// IsBot reports whether the author of a PR is a bot, as flagged by the platform or by name
+func (af *AuthorFilter) IsBot(pr *platform.PullRequestData) bool {
+	return pr.AuthorIsBot || af.bots.IsBot(pr.Author)
+}
+
+// Excluded returns the reason a PR is left out of the analysis, or an empty string if it is analyzed.
+// Include patterns win over the bot list, so a bot can be analyzed by including it explicitly.
+func (af *AuthorFilter) Excluded(pr *platform.PullRequestData) string {
+	if matchAccount(af.exclude, pr.Author) {
+		return ExcludedByExclude
+	}
+	if len(af.include) > 0 {
+		if matchAccount(af.include, pr.Author) {
+			return ""
+		}
+		return ExcludedNotIncluded
+	}
+	if af.excludeBots && af.IsBot(pr) {
+		return ExcludedBot
+	}
+	return ""
+}
+
+// Filter splits PRs into the analyzed ones and the number of excluded PRs per reason
+func (af *AuthorFilter) Filter(prs []*platform.PullRequestData) ([]*platform.PullRequestData, map[string]int) {
+	kept := make([]*platform.PullRequestData, 0, len(prs))
+	excluded := make(map[string]int)
+	for _, pr := range prs {
+		if reason := af.Excluded(pr); reason != "" {
+			excluded[reason]++
+			continue
+		}
+		kept = append(kept, pr)
+	}
+	return kept, excluded
+}
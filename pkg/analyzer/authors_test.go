@@ -0,0 +1,84 @@
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/bug-crawler/pkg/platform"
+)
+
+func TestAuthorFilter_Excluded(t *testing.T) {
+	af, err := NewAuthorFilter(nil, nil)
+	if err != nil {
+		t.Fatalf("NewAuthorFilter() error = %v", err)
+	}
+
+	tests := []struct {
+		pr   *platform.PullRequestData
+		want string
+	}{
+		{&platform.PullRequestData{Author: "alice"}, ""},
+		{&platform.PullRequestData{Author: "dependabot[bot]"}, ExcludedBot},
+		{&platform.PullRequestData{Author: "renovate-approve"}, ExcludedBot},
+		{&platform.PullRequestData{Author: "Release Bot"}, ExcludedBot},
+		{&platform.PullRequestData{Author: "Bitbucket Pipelines"}, ExcludedBot},
+		{&platform.PullRequestData{Author: "ci-runner", AuthorIsBot: true}, ExcludedBot},
+		{&platform.PullRequestData{Author: "abbot"}, ""},
+	}
+
+	for _, tt := range tests {
+		if got := af.Excluded(tt.pr); got != tt.want {
+			t.Errorf("Excluded(%q) = %q, want %q", tt.pr.Author, got, tt.want)
+		}
+	}
+}
+
+func TestAuthorFilter_Config(t *testing.T) {
+	keepBots := false
+	af, err := NewAuthorFilter(&AuthorFilterConfig{
+		Include:     []string{"team-?-*", `/^(alice|bob)$/`, "dependabot[bot]"},
+		Exclude:     []string{"Bob"},
+		ExcludeBots: &keepBots,
+	}, nil)
+	if err != nil {
+		t.Fatalf("NewAuthorFilter() error = %v", err)
+	}
+
+	prs := []*platform.PullRequestData{
+		{Author: "alice"},
+		{Author: "bob"},
+		{Author: "team-a-carol"},
+		{Author: "dependabot[bot]"},
+		{Author: "dave"},
+	}
+	kept, excluded := af.Filter(prs)
+
+	if len(kept) != 3 || kept[0].Author != "alice" || kept[1].Author != "team-a-carol" || kept[2].Author != "dependabot[bot]" {
+		t.Errorf("Filter() kept %d PRs, want alice, team-a-carol, dependabot[bot]", len(kept))
+	}
+	if excluded[ExcludedByExclude] != 1 || excluded[ExcludedNotIncluded] != 1 {
+		t.Errorf("Filter() excluded = %v, want 1 exclude and 1 not_included", excluded)
+	}
+
+	if _, err := NewAuthorFilter(&AuthorFilterConfig{Exclude: []string{"/(/"}}, nil); err == nil {
+		t.Error("NewAuthorFilter() with an invalid regex should fail")
+	}
+}
+
+func TestAuthorFilter_SharedBots(t *testing.T) {
+	bots, err := NewBotMatcher([]string{"Release Train"})
+	if err != nil {
+		t.Fatalf("NewBotMatcher() error = %v", err)
+	}
+	af, err := NewAuthorFilter(&AuthorFilterConfig{}, bots)
+	if err != nil {
+		t.Fatalf("NewAuthorFilter() error = %v", err)
+	}
+
+	if got := af.Excluded(&platform.PullRequestData{Author: "release train"}); got != ExcludedBot {
+		t.Errorf("Excluded(release train) = %q, want %q", got, ExcludedBot)
+	}
+	// The configured list replaces the defaults
+	if got := af.Excluded(&platform.PullRequestData{Author: "dependabot[bot]"}); got != "" {
+		t.Errorf("Excluded(dependabot[bot]) = %q, want analyzed", got)
+	}
+}
@@ -0,0 +1,120 @@
+package analyzer
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// DefaultBotPatterns are the accounts treated as bots when no bot list is configured: GitHub apps
+// ("dependabot[bot]"), dependency bots and service accounts named "... Bot" or "bitbucket-pipelines"
+// on Bitbucket/Backlog. They are left out as PR authors and ignored as reviewers.
+var DefaultBotPatterns = []string{
+	"*[bot]",
+	"*-bot",
+	"*_bot",
+	"* bot",
+	"dependabot*",
+	"renovate*",
+	"snyk-*",
+	"bitbucket-pipelines",
+	"bitbucket pipelines",
+}
+
+// DefaultBotMatcher matches DefaultBotPatterns
+var DefaultBotMatcher = mustBotMatcher(DefaultBotPatterns)
+
+// BotMatcher recognizes bot accounts by login or display name. Patterns are compiled once.
+type BotMatcher struct {
+	patterns []accountPattern
+}
+
+// accountPattern matches a login or name exactly or with a regex
+type accountPattern struct {
+	exact string
+	re    *regexp.Regexp
+}
+
+// NewBotMatcher compiles bot patterns (DefaultBotMatcher if nil, no bots if empty).
+// Patterns are exact logins or names ("Release Bot"), globs with "*" and "?" ("ci-*")
+// or regexes between slashes ("/^svc-\d+$/"), all case-insensitive.
+func NewBotMatcher(patterns []string) (*BotMatcher, error) {
+	if patterns == nil {
+		return DefaultBotMatcher, nil
+	}
+	compiled, err := compileAccountPatterns(patterns)
+	if err != nil {
+		return nil, err
+	}
+	return &BotMatcher{patterns: compiled}, nil
+}
+
+// mustBotMatcher compiles built-in bot patterns, which always compile
+func mustBotMatcher(patterns []string) *BotMatcher {
+	compiled, err := compileAccountPatterns(patterns)
+	if err != nil {
+		panic(err)
+	}
+	return &BotMatcher{patterns: compiled}
+}
+
+// IsBot reports whether a login or name matches one of the bot patterns
+func (bm *BotMatcher) IsBot(login string) bool {
+	if bm == nil {
+		return false
+	}
+	return matchAccount(bm.patterns, login)
+}
+
+// compileAccountPatterns converts exact, glob and /regex/ patterns
+func compileAccountPatterns(patterns []string) ([]accountPattern, error) {
+	compiled := make([]accountPattern, 0, len(patterns))
+	for _, pattern := range patterns {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+
+		switch {
+		case len(pattern) > 2 && strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/"):
+			re, err := regexp.Compile("(?i)" + pattern[1:len(pattern)-1])
+			if err != nil {
+				return nil, fmt.Errorf("regex không hợp lệ %q: %w", pattern, err)
+			}
+			compiled = append(compiled, accountPattern{re: re})
+		case strings.ContainsAny(pattern, "*?"):
+			// Brackets match literally so that "*[bot]" works
+			var expr strings.Builder
+			for _, r := range pattern {
+				switch r {
+				case '*':
+					expr.WriteString(".*")
+				case '?':
+					expr.WriteString(".")
+				default:
+					expr.WriteString(regexp.QuoteMeta(string(r)))
+				}
+			}
+			compiled = append(compiled, accountPattern{re: regexp.MustCompile("(?i)^" + expr.String() + "$")})
+		default:
+			compiled = append(compiled, accountPattern{exact: pattern})
+		}
+	}
+	return compiled, nil
+}
+
+func (p accountPattern) match(login string) bool {
+	if p.re != nil {
+		return p.re.MatchString(login)
+	}
+	return strings.EqualFold(p.exact, login)
+}
+
+func matchAccount(patterns []accountPattern, login string) bool {
+	for _, p := range patterns {
+		if p.match(login) {
+			return true
+		}
+	}
+	return false
+}
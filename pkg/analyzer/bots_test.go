@@ -0,0 +1,45 @@
+package analyzer
+
+import "testing"
+
+func TestBotMatcher_IsBot(t *testing.T) {
+	tests := []struct {
+		login string
+		want  bool
+	}{
+		{"dependabot[bot]", true},
+		{"GitHub-Actions[bot]", true},
+		{"Release Bot", true},
+		{"bitbucket-pipelines", true},
+		{"robot-dev", false},
+		{"abbot", false},
+	}
+
+	for _, tt := range tests {
+		if got := DefaultBotMatcher.IsBot(tt.login); got != tt.want {
+			t.Errorf("IsBot(%q) = %v, want %v", tt.login, got, tt.want)
+		}
+	}
+}
+
+func TestNewBotMatcher(t *testing.T) {
+	if bm, err := NewBotMatcher(nil); err != nil || bm != DefaultBotMatcher {
+		t.Errorf("NewBotMatcher(nil) = %v, %v; want DefaultBotMatcher", bm, err)
+	}
+
+	bm, err := NewBotMatcher([]string{"ci-*", `/^svc-\d+$/`})
+	if err != nil {
+		t.Fatalf("NewBotMatcher() error = %v", err)
+	}
+	if !bm.IsBot("CI-Runner") || !bm.IsBot("svc-42") || bm.IsBot("dependabot[bot]") {
+		t.Error("Configured patterns should replace the defaults")
+	}
+
+	if bm, err := NewBotMatcher([]string{}); err != nil || bm.IsBot("dependabot[bot]") {
+		t.Error("An empty bot list should match no account")
+	}
+
+	if _, err := NewBotMatcher([]string{"/[/"}); err == nil {
+		t.Error("Expected error for an invalid regex")
+	}
+}
@@ -0,0 +1,158 @@
+package analyzer
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/bug-crawler/pkg/platform"
+)
+
+// Severities of the bug_review breakdown; any other breakdown key is a category
+const (
+	SeverityCritical = "critical"
+	SeverityMajor    = "major"
+	SeverityMinor    = "minor"
+)
+
+// Severities lists the bug severities from the most to the least severe
+var Severities = []string{SeverityCritical, SeverityMajor, SeverityMinor}
+
+// Sources of bug_review tags
+const (
+	BugReviewSourceDescription = "description"
+	BugReviewSourceReview      = "review"
+)
+
+var (
+	// bugReviewTagRegex matches "bug_review: 3" with an optional breakdown "(major: 1, minor: 2, ui: 1)"
+	bugReviewTagRegex = regexp.MustCompile(`(?i)bug_review\s*:\s*(\d+)(?:\s*\(([^)]*)\))?`)
+	// bugReviewEntryRegex matches one breakdown entry: "major: 1", "ui=2"
+	bugReviewEntryRegex = regexp.MustCompile(`^\s*([^:=]+?)\s*[:=]\s*(\d+)\s*$`)
+)
+
+// BugReviewTag is a bug_review tag found in the PR description or a review comment
+type BugReviewTag struct {
+	Source     string         // BugReviewSourceDescription or BugReviewSourceReview
+	Reviewer   string         // Author of the review comment, empty for the description
+	Count      int            // Total number of bugs; 0 records a review without bugs
+	BySeverity map[string]int // Breakdown per severity (critical, major, minor)
+	ByCategory map[string]int // Breakdown per free-form category (ui, data, ...)
+}
+
+// ParseBugReviewTags returns every bug_review tag of a text, in order.
+// Breakdown keys are lower-cased; entries that are not "key: number" are ignored.
+func ParseBugReviewTags(text string) []BugReviewTag {
+	var tags []BugReviewTag
+	for _, match := range bugReviewTagRegex.FindAllStringSubmatch(text, -1) {
+		count, err := strconv.Atoi(match[1])
+		if err != nil {
+			continue // Out of range
+		}
+		tag := BugReviewTag{
+			Count:      count,
+			BySeverity: make(map[string]int),
+			ByCategory: make(map[string]int),
+		}
+
+		for _, entry := range strings.FieldsFunc(match[2], func(r rune) bool { return r == ',' || r == ';' }) {
+			parts := bugReviewEntryRegex.FindStringSubmatch(entry)
+			if parts == nil {
+				continue
+			}
+			n, err := strconv.Atoi(parts[2])
+			if err != nil {
+				continue
+			}
+			key := strings.ToLower(parts[1])
+			if isSeverity(key) {
+				tag.BySeverity[key] += n
+			} else {
+				tag.ByCategory[key] += n
+			}
+		}
+
+		tags = append(tags, tag)
+	}
+	return tags
+}
+
+// isSeverity reports whether a breakdown key is one of Severities
+func isSeverity(key string) bool {
+	for _, severity := range Severities {
+		if key == severity {
+			return true
+		}
+	}
+	return false
+}
+
+// bugReviewTags collects the bug_review tags of the description and, when reviews were fetched, of every review comment.
+// Review tags are attributed to their reviewer. A description tag repeating a review tag (same count and breakdown,
+// typically copied by the author) is dropped so the bugs are counted once.
+func bugReviewTags(pr *platform.PullRequestData) []BugReviewTag {
+	var reviewTags []BugReviewTag
+	for _, review := range pr.Reviews {
+		for _, tag := range ParseBugReviewTags(review.CommentBody) {
+			tag.Source = BugReviewSourceReview
+			tag.Reviewer = review.ReviewerLogin
+			reviewTags = append(reviewTags, tag)
+		}
+	}
+
+	var tags []BugReviewTag
+	matched := make([]bool, len(reviewTags))
+	for _, tag := range ParseBugReviewTags(pr.Description) {
+		duplicate := false
+		for i, reviewTag := range reviewTags {
+			if !matched[i] && sameBugReviewCount(tag, reviewTag) {
+				matched[i] = true
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			tag.Source = BugReviewSourceDescription
+			tags = append(tags, tag)
+		}
+	}
+	return append(tags, reviewTags...)
+}
+
+// sameBugReviewCount reports whether two tags record the same count and breakdown
+func sameBugReviewCount(a, b BugReviewTag) bool {
+	return a.Count == b.Count && sameBreakdown(a.BySeverity, b.BySeverity) && sameBreakdown(a.ByCategory, b.ByCategory)
+}
+
+func sameBreakdown(a, b map[string]int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for key, n := range a {
+		if b[key] != n {
+			return false
+		}
+	}
+	return true
+}
+
+// applyBugReviewTags stores the tags on a result and sums their counts and breakdowns
+func applyBugReviewTags(result *BugResult, tags []BugReviewTag) {
+	result.BugReviewTags = tags
+	result.BugCount = 0
+	result.BugsBySeverity = make(map[string]int)
+	result.BugsByCategory = make(map[string]int)
+	result.BugsByReviewer = make(map[string]int)
+	for _, tag := range tags {
+		result.BugCount += tag.Count
+		if tag.Reviewer != "" {
+			result.BugsByReviewer[tag.Reviewer] += tag.Count
+		}
+		for severity, n := range tag.BySeverity {
+			result.BugsBySeverity[severity] += n
+		}
+		for category, n := range tag.ByCategory {
+			result.BugsByCategory[category] += n
+		}
+	}
+}
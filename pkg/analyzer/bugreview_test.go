@@ -0,0 +1,126 @@
+package analyzer
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/bug-crawler/pkg/platform"
+)
+
+func TestParseBugReviewTags(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []BugReviewTag
+	}{
+		{
+			name: "count only",
+			text: "bug_review: 2",
+			want: []BugReviewTag{{Count: 2, BySeverity: map[string]int{}, ByCategory: map[string]int{}}},
+		},
+		{
+			name: "severity breakdown",
+			text: "BUG_REVIEW: 3 (Major: 1, minor: 2)",
+			want: []BugReviewTag{{Count: 3, BySeverity: map[string]int{"major": 1, "minor": 2}, ByCategory: map[string]int{}}},
+		},
+		{
+			name: "severity and category breakdown",
+			text: "bug_review: 2 (critical: 1; UI = 1; data: 1; garbage)",
+			want: []BugReviewTag{{Count: 2, BySeverity: map[string]int{"critical": 1}, ByCategory: map[string]int{"ui": 1, "data": 1}}},
+		},
+		{
+			name: "repeated tags and zero",
+			text: "bug_review: 1\nlater bug_review: 0",
+			want: []BugReviewTag{
+				{Count: 1, BySeverity: map[string]int{}, ByCategory: map[string]int{}},
+				{Count: 0, BySeverity: map[string]int{}, ByCategory: map[string]int{}},
+			},
+		},
+		{
+			name: "no tag",
+			text: "bug_review: none",
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseBugReviewTags(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseBugReviewTags(%q) = %+v, want %+v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnalyzePR_BugReviewSummed(t *testing.T) {
+	pr := &platform.PullRequestData{
+		Description: "bug_review: 1 (major: 1)",
+		Reviews: []*platform.ReviewData{
+			{ReviewerLogin: "r1", CommentBody: "bug_review: 2 (minor: 2, ui: 1)"},
+			{ReviewerLogin: "r2", CommentBody: "LGTM"},
+		},
+	}
+
+	result := NewBugAnalyzer().AnalyzePR(pr, "bug_review", "github")
+	if !result.IsBugRelated || result.BugCount != 3 {
+		t.Fatalf("AnalyzePR() = related %v, count %d, want related with 3 bugs", result.IsBugRelated, result.BugCount)
+	}
+	if !reflect.DeepEqual(result.BugsBySeverity, map[string]int{"major": 1, "minor": 2}) {
+		t.Errorf("BugsBySeverity = %v", result.BugsBySeverity)
+	}
+	if !reflect.DeepEqual(result.BugsByCategory, map[string]int{"ui": 1}) {
+		t.Errorf("BugsByCategory = %v", result.BugsByCategory)
+	}
+	if len(result.BugReviewTags) != 2 || result.BugReviewTags[1].Source != BugReviewSourceReview {
+		t.Errorf("BugReviewTags = %+v, want description then review", result.BugReviewTags)
+	}
+}
+
+func TestAnalyzePR_BugReviewInReviews(t *testing.T) {
+	analyzer := NewBugAnalyzer()
+
+	t.Run("attributed to reviewer", func(t *testing.T) {
+		pr := &platform.PullRequestData{
+			Description: "Fix login",
+			Reviews: []*platform.ReviewData{
+				{ReviewerLogin: "alice", CommentBody: "bug_review: 2"},
+				{ReviewerLogin: "bob", CommentBody: "bug_review: 1 (minor: 1)"},
+			},
+		}
+		result := analyzer.AnalyzePR(pr, "bug_review", "github")
+		if !result.IsBugRelated || result.BugCount != 3 {
+			t.Fatalf("AnalyzePR() = related %v, count %d, want related with 3 bugs", result.IsBugRelated, result.BugCount)
+		}
+		if !reflect.DeepEqual(result.BugsByReviewer, map[string]int{"alice": 2, "bob": 1}) {
+			t.Errorf("BugsByReviewer = %v", result.BugsByReviewer)
+		}
+	})
+
+	t.Run("description copy counted once", func(t *testing.T) {
+		pr := &platform.PullRequestData{
+			Description: "bug_review: 2 (major: 2)",
+			Reviews: []*platform.ReviewData{
+				{ReviewerLogin: "alice", CommentBody: "Found issues. bug_review: 2 (major: 2)"},
+			},
+		}
+		result := analyzer.AnalyzePR(pr, "bug_review", "github")
+		if result.BugCount != 2 || result.BugsByReviewer["alice"] != 2 {
+			t.Errorf("AnalyzePR() count %d, by reviewer %v, want 2 attributed to alice", result.BugCount, result.BugsByReviewer)
+		}
+		if len(result.BugReviewTags) != 1 || result.BugReviewTags[0].Source != BugReviewSourceReview {
+			t.Errorf("BugReviewTags = %+v, want only the review tag", result.BugReviewTags)
+		}
+	})
+
+	t.Run("different counts summed", func(t *testing.T) {
+		pr := &platform.PullRequestData{
+			Description: "bug_review: 1",
+			Reviews: []*platform.ReviewData{
+				{ReviewerLogin: "alice", CommentBody: "bug_review: 2"},
+			},
+		}
+		if result := analyzer.AnalyzePR(pr, "bug_review", "github"); result.BugCount != 3 {
+			t.Errorf("AnalyzePR() count %d, want 3", result.BugCount)
+		}
+	})
+}
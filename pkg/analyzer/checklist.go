@@ -0,0 +1,81 @@
+package analyzer
+
+import (
+	"regexp"
+	"strings"
+)
+
+// DefaultChecklistRuleSet is the name of the built-in Self-Review checklist rule set
+const DefaultChecklistRuleSet = "self_review_checklist"
+
+var taskItemRegex = regexp.MustCompile(`^\s*(?:[-*+]|\d+[.)])\s+\[([ xX])\](?:\s+(.*))?$`)
+
+// ChecklistItem is one task list item ("- [ ] ..." or "- [x] ...")
+type ChecklistItem struct {
+	Section string // Heading the item was found under
+	Text    string
+	Checked bool
+}
+
+// ChecklistResult contains the task list items found under the checklist headings of a rule set
+type ChecklistResult struct {
+	Items     []ChecklistItem
+	Checked   int
+	Unchecked int
+}
+
+// Total returns the number of checklist items
+func (cr *ChecklistResult) Total() int {
+	return cr.Checked + cr.Unchecked
+}
+
+// CompletionRate returns the percentage of checked items, 0 if the checklist is empty
+func (cr *ChecklistResult) CompletionRate() float64 {
+	if cr.Total() == 0 {
+		return 0
+	}
+	return float64(cr.Checked) * 100 / float64(cr.Total())
+}
+
+// ParseChecklist returns the task list items of a Markdown section body.
+// Items inside fenced code blocks are ignored.
+func ParseChecklist(section *MarkdownSection) []ChecklistItem {
+	var items []ChecklistItem
+
+	inFence := false
+	for _, line := range strings.Split(section.Content, "\n") {
+		line = strings.TrimRight(line, "\r")
+		if fenceRegex.MatchString(line) {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+
+		matches := taskItemRegex.FindStringSubmatch(line)
+		if matches == nil {
+			continue
+		}
+		items = append(items, ChecklistItem{
+			Section: section.Heading,
+			Text:    strings.TrimSpace(matches[2]),
+			Checked: matches[1] != " ",
+		})
+	}
+
+	return items
+}
+
+// checklistPassed checks the completion requirement of a checklist rule set.
+// Without RequireAll or MinCheckedPercent the checklist is only reported.
+func (rs *compiledRuleSet) checklistPassed(checklist *ChecklistResult) bool {
+	switch {
+	case rs.RequireAll:
+		return checklist.Total() > 0 && checklist.Unchecked == 0
+	case rs.MinCheckedPercent > 0:
+		return checklist.Total() > 0 && checklist.CompletionRate() >= rs.MinCheckedPercent
+	default:
+		return true
+	}
+}
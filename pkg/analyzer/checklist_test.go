@@ -0,0 +1,91 @@
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/bug-crawler/pkg/platform"
+)
+
+const checklistDescription = "## Description\nAdd 2FA\n## Self-Review\n- [x] Code đã được test\n* [X] Đã kiểm tra performance\n- [ ] Đã update documentation\n```\n- [ ] not an item\n```\n## Security\n- [ ] Not part of the checklist\n"
+
+func TestParseChecklist(t *testing.T) {
+	sections := ParseMarkdownSections(checklistDescription)
+
+	items := ParseChecklist(sections[1])
+	if len(items) != 3 {
+		t.Fatalf("Got %d items, want 3: %+v", len(items), items)
+	}
+	if !items[0].Checked || !items[1].Checked || items[2].Checked {
+		t.Errorf("Checked states = %+v", items)
+	}
+	if items[2].Text != "Đã update documentation" || items[2].Section != "Self-Review" {
+		t.Errorf("Item = %+v", items[2])
+	}
+}
+
+func TestAnalyzePRRule_Checklist(t *testing.T) {
+	checklistRule := func(requireAll bool, minPercent float64) *RuleConfig {
+		return &RuleConfig{RuleSets: []RuleSet{{
+			Name:              "checklist",
+			Target:            RuleTargetDescription,
+			Match:             RuleMatchChecklist,
+			Items:             []RuleItem{{Keyword: "Self-Review", Patterns: []string{`self-review|self review`}}},
+			RequireAll:        requireAll,
+			MinCheckedPercent: minPercent,
+		}}}
+	}
+
+	tests := []struct {
+		name        string
+		config      *RuleConfig
+		description string
+		wantPassed  bool
+	}{
+		{name: "report only", config: checklistRule(false, 0), description: checklistDescription, wantPassed: true},
+		{name: "require all", config: checklistRule(true, 0), description: checklistDescription, wantPassed: false},
+		{name: "60 percent", config: checklistRule(false, 60), description: checklistDescription, wantPassed: true},
+		{name: "70 percent", config: checklistRule(false, 70), description: checklistDescription, wantPassed: false},
+		{name: "no checklist", config: checklistRule(false, 50), description: "## Self-Review\nDone\n", wantPassed: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			analyzer, err := NewPRRuleAnalyzerWithConfig(tt.config)
+			if err != nil {
+				t.Fatalf("NewPRRuleAnalyzerWithConfig failed: %v", err)
+			}
+
+			result := analyzer.AnalyzePRRule(&platform.PullRequestData{Description: tt.description})
+			ruleResult := result.RuleResults[0]
+
+			if ruleResult.Passed != tt.wantPassed || result.PRDescriptionValid != tt.wantPassed {
+				t.Errorf("Passed = %v, PRDescriptionValid = %v, want %v", ruleResult.Passed, result.PRDescriptionValid, tt.wantPassed)
+			}
+			if ruleResult.Checklist == nil {
+				t.Fatal("Expected checklist result")
+			}
+		})
+	}
+
+	analyzer, _ := NewPRRuleAnalyzerWithConfig(checklistRule(false, 0))
+	checklist := analyzer.AnalyzePRRule(&platform.PullRequestData{Description: checklistDescription}).RuleResults[0].Checklist
+	if checklist.Checked != 2 || checklist.Unchecked != 1 || checklist.Total() != 3 {
+		t.Errorf("Checklist = %+v, want 2 checked of 3", checklist)
+	}
+	if rate := checklist.CompletionRate(); rate < 66.6 || rate > 66.7 {
+		t.Errorf("CompletionRate = %.2f, want 66.67", rate)
+	}
+}
+
+func TestNewPRRuleAnalyzerWithConfig_InvalidChecklist(t *testing.T) {
+	configs := []RuleSet{
+		{Name: "review", Target: RuleTargetReview, Match: RuleMatchChecklist, Items: []RuleItem{{Keyword: "X"}}},
+		{Name: "percent", Target: RuleTargetDescription, Match: RuleMatchChecklist, MinCheckedPercent: 150, Items: []RuleItem{{Keyword: "X"}}},
+	}
+
+	for _, ruleSet := range configs {
+		if _, err := NewPRRuleAnalyzerWithConfig(&RuleConfig{RuleSets: []RuleSet{ruleSet}}); err == nil {
+			t.Errorf("Expected error for rule set %s", ruleSet.Name)
+		}
+	}
+}
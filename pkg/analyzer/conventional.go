@@ -0,0 +1,47 @@
+package analyzer
+
+import (
+	"regexp"
+	"strings"
+)
+
+// DefaultTitleTypes are the Conventional Commits types of bug-fix PRs
+var DefaultTitleTypes = []string{"fix", "bugfix", "hotfix", "revert"}
+
+// conventionalTitleRegex matches "type(scope)!: subject"; scope and "!" are optional
+var conventionalTitleRegex = regexp.MustCompile(`^\s*([A-Za-z][\w-]*)(?:\(([^()]*)\))?(!)?:\s*(\S.*)$`)
+
+// ConventionalTitle is a PR title parsed with the Conventional Commits format, e.g. "fix(api): handle nil user"
+type ConventionalTitle struct {
+	Type     string // Lower-cased type, e.g. "fix"
+	Scope    string // Component between parentheses, empty if absent
+	Breaking bool   // "!" after the type or scope
+	Subject  string
+}
+
+// ParseConventionalTitle parses a PR title in the Conventional Commits format
+func ParseConventionalTitle(title string) (*ConventionalTitle, bool) {
+	matches := conventionalTitleRegex.FindStringSubmatch(title)
+	if matches == nil {
+		return nil, false
+	}
+
+	return &ConventionalTitle{
+		Type:     strings.ToLower(matches[1]),
+		Scope:    strings.TrimSpace(matches[2]),
+		Breaking: matches[3] == "!",
+		Subject:  strings.TrimSpace(matches[4]),
+	}, true
+}
+
+// matchTitle checks if the PR title is a Conventional Commits title of a bug-fix type.
+// It returns the matched prefix, e.g. "fix(api)".
+func (ba *BugAnalyzer) matchTitle(title *ConventionalTitle) (string, bool) {
+	if title == nil || !ba.titleTypes[title.Type] {
+		return "", false
+	}
+	if title.Scope != "" {
+		return title.Type + "(" + title.Scope + ")", true
+	}
+	return title.Type, true
+}
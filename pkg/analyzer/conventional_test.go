@@ -0,0 +1,88 @@
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/bug-crawler/pkg/platform"
+)
+
+func TestParseConventionalTitle(t *testing.T) {
+	tests := []struct {
+		title  string
+		want   *ConventionalTitle
+		wantOK bool
+	}{
+		{"fix(api): handle nil user", &ConventionalTitle{Type: "fix", Scope: "api", Subject: "handle nil user"}, true},
+		{"Feat!: drop v1 endpoints", &ConventionalTitle{Type: "feat", Breaking: true, Subject: "drop v1 endpoints"}, true},
+		{"hotfix(payment/card)!: retry", &ConventionalTitle{Type: "hotfix", Scope: "payment/card", Breaking: true, Subject: "retry"}, true},
+		{"Fix login crash", nil, false},
+		{"fix:", nil, false},
+		{"[ABC-1] fix: x", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			got, ok := ParseConventionalTitle(tt.title)
+			if ok != tt.wantOK {
+				t.Fatalf("ParseConventionalTitle() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && *got != *tt.want {
+				t.Errorf("ParseConventionalTitle() = %+v, want %+v", *got, *tt.want)
+			}
+		})
+	}
+}
+
+func TestAnalyzePR_Title(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      *BugDetectionConfig
+		title       string
+		wantBug     bool
+		wantKeyword string
+		wantScope   string
+	}{
+		{name: "fix with scope", title: "fix(api): handle nil user", wantBug: true, wantKeyword: "fix(api)", wantScope: "api"},
+		{name: "revert", title: "revert: feat(auth): sso", wantBug: true, wantKeyword: "revert"},
+		{name: "feature keeps scope", title: "feat(web): dark mode", wantBug: false, wantScope: "web"},
+		{name: "not conventional", title: "Fix login crash", wantBug: false},
+		{
+			name:        "custom types",
+			config:      &BugDetectionConfig{TitleTypes: []string{"Defect"}},
+			title:       "defect(core): overflow",
+			wantBug:     true,
+			wantKeyword: "defect(core)",
+			wantScope:   "core",
+		},
+		{
+			name:      "custom types replace defaults",
+			config:    &BugDetectionConfig{TitleTypes: []string{"defect"}},
+			title:     "fix(core): overflow",
+			wantBug:   false,
+			wantScope: "core",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			analyzer, err := NewBugAnalyzerWithConfig(tt.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			result := analyzer.AnalyzePR(&platform.PullRequestData{Title: tt.title}, "title", "github")
+			if result.IsBugRelated != tt.wantBug {
+				t.Errorf("IsBugRelated = %v, want %v", result.IsBugRelated, tt.wantBug)
+			}
+			if tt.wantBug && result.DetectionType != "title" {
+				t.Errorf("DetectionType = %q, want title", result.DetectionType)
+			}
+			if result.MatchedKeyword != tt.wantKeyword {
+				t.Errorf("MatchedKeyword = %q, want %q", result.MatchedKeyword, tt.wantKeyword)
+			}
+			if result.Scope != tt.wantScope {
+				t.Errorf("Scope = %q, want %q", result.Scope, tt.wantScope)
+			}
+		})
+	}
+}
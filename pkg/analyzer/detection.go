@@ -0,0 +1,152 @@
+package analyzer
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/bug-crawler/pkg/platform"
+)
+
+// DefaultBranchPatterns match the source branches of bug fixes: fix/..., bugfix/..., hotfix/..., bug-...
+var DefaultBranchPatterns = []string{`^(?:bug|bugfix|fix|hotfix)[/_-]`}
+
+// BugDetectionConfig configures how BugAnalyzer detects bug-related PRs
+type BugDetectionConfig struct {
+	BranchPatterns []string `json:"branch_patterns,omitempty"` // Case-insensitive regexes on the source branch, DefaultBranchPatterns if empty
+	TitleTypes     []string `json:"title_types,omitempty"`     // Conventional Commits types of bug fixes, DefaultTitleTypes if empty
+	IssueBugTypes  []string `json:"issue_bug_types,omitempty"` // Types or labels of bug issues in the tracker, DefaultIssueBugTypes if empty
+	// Project keys of tracker issues referenced from PRs ("PROJ" for "PROJ-123"), e.g. the Jira projects.
+	// Other KEY-123 tokens ("UTF-8", "SHA-256") are not issue references; the Backlog project is always known.
+	IssueProjectKeys []string `json:"issue_project_keys,omitempty"`
+	// Bug labels of PRs, globally and per organization or repository; DefaultBugLabels if not set
+	Labels *LabelTaxonomyConfig `json:"labels,omitempty"`
+	// Combined mode: weight (0-1) of each signal, merged into DefaultSignalWeights,
+	// and minimum confidence of a bug-related PR, DefaultConfidenceThreshold if 0
+	SignalWeights map[string]float64 `json:"signal_weights,omitempty"`
+	Threshold     float64            `json:"threshold,omitempty"`
+	// Classification of bug-related PRs: severity or category → label values (without prefix such as "severity:")
+	// and case-insensitive regexes on the title and description. The defaults are used for each empty mapping.
+	SeverityLabels   map[string][]string `json:"severity_labels,omitempty"`
+	SeverityKeywords map[string][]string `json:"severity_keywords,omitempty"`
+	CategoryLabels   map[string][]string `json:"category_labels,omitempty"`
+	CategoryKeywords map[string][]string `json:"category_keywords,omitempty"`
+	// Window after a merge within which reverts and fixes are linked to the merged PR, DefaultFollowUpDays if 0
+	FollowUpDays int `json:"follow_up_days,omitempty"`
+}
+
+// NewBugAnalyzerWithConfig initializes a BugAnalyzer with a custom detection config (defaults if nil)
+func NewBugAnalyzerWithConfig(config *BugDetectionConfig) (*BugAnalyzer, error) {
+	if config == nil {
+		config = &BugDetectionConfig{}
+	}
+
+	ba := NewBugAnalyzer()
+
+	if len(config.BranchPatterns) > 0 {
+		branchPatterns, err := compileDetectionPatterns(config.BranchPatterns)
+		if err != nil {
+			return nil, fmt.Errorf("branch_patterns: %w", err)
+		}
+		ba.branchPatterns = branchPatterns
+	}
+
+	if config.Labels != nil {
+		labels, err := newLabelTaxonomy(config.Labels)
+		if err != nil {
+			return nil, fmt.Errorf("labels.%w", err)
+		}
+		ba.labels = labels
+	}
+
+	if len(config.TitleTypes) > 0 {
+		ba.titleTypes = titleTypeSet(config.TitleTypes)
+	}
+
+	if len(config.IssueBugTypes) > 0 {
+		ba.issueBugTypes = titleTypeSet(config.IssueBugTypes)
+	}
+
+	for _, key := range config.IssueProjectKeys {
+		key = strings.ToUpper(strings.TrimSpace(key))
+		if !issueProjectKeyRegex.MatchString(key) {
+			return nil, fmt.Errorf("issue_project_keys: project key không hợp lệ %q", key)
+		}
+		ba.issueProjectKeys = append(ba.issueProjectKeys, key)
+	}
+
+	signalWeights, err := validateSignalWeights(config.SignalWeights)
+	if err != nil {
+		return nil, err
+	}
+	ba.signalWeights = signalWeights
+
+	if config.Threshold < 0 || config.Threshold > 1 {
+		return nil, fmt.Errorf("threshold phải trong khoảng 0-1, nhận %g", config.Threshold)
+	}
+	if config.Threshold > 0 {
+		ba.threshold = config.Threshold
+	}
+
+	if len(config.SeverityLabels) > 0 {
+		if err := validateSeverities(config.SeverityLabels); err != nil {
+			return nil, fmt.Errorf("severity_labels: %w", err)
+		}
+		ba.severityLabels = newLabelClassifier(config.SeverityLabels, Severities)
+	}
+	if len(config.SeverityKeywords) > 0 {
+		if err := validateSeverities(config.SeverityKeywords); err != nil {
+			return nil, fmt.Errorf("severity_keywords: %w", err)
+		}
+		if ba.severityKeywords, err = newKeywordClassifiers(config.SeverityKeywords, Severities); err != nil {
+			return nil, fmt.Errorf("severity_keywords: %w", err)
+		}
+	}
+	if len(config.CategoryLabels) > 0 {
+		categoryLabels := lowerClasses(config.CategoryLabels)
+		ba.categoryLabels = newLabelClassifier(categoryLabels, sortedClasses(categoryLabels))
+	}
+	if len(config.CategoryKeywords) > 0 {
+		categoryKeywords := lowerClasses(config.CategoryKeywords)
+		if ba.categoryKeywords, err = newKeywordClassifiers(categoryKeywords, sortedClasses(categoryKeywords)); err != nil {
+			return nil, fmt.Errorf("category_keywords: %w", err)
+		}
+	}
+
+	return ba, nil
+}
+
+// compileDetectionPatterns compiles case-insensitive detection regexes
+func compileDetectionPatterns(patterns []string) ([]*regexp.Regexp, error) {
+	compiled := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		re, err := regexp.Compile("(?i)" + pattern)
+		if err != nil {
+			return nil, fmt.Errorf("regex không hợp lệ %q: %w", pattern, err)
+		}
+		compiled = append(compiled, re)
+	}
+	return compiled, nil
+}
+
+// titleTypeSet builds a case-insensitive lookup of Conventional Commits types or issue types
+func titleTypeSet(types []string) map[string]bool {
+	set := make(map[string]bool, len(types))
+	for _, t := range types {
+		set[strings.ToLower(strings.TrimSpace(t))] = true
+	}
+	return set
+}
+
+// matchBranch returns the first branch pattern matching the source branch of a PR
+func (ba *BugAnalyzer) matchBranch(pr *platform.PullRequestData) (string, bool) {
+	if pr.SourceBranch == "" {
+		return "", false
+	}
+	for _, re := range ba.branchPatterns {
+		if re.MatchString(pr.SourceBranch) {
+			return pr.SourceBranch, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,90 @@
+package analyzer
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// Release stages of a target branch
+const (
+	StageDevelop = "develop"
+	StageRelease = "release"
+	StageMain    = "main"
+	StageOther   = "other"
+)
+
+var (
+	// DefaultDevelopBranches match integration branches, where bugs are fixed before a release
+	DefaultDevelopBranches = []string{`^(?:develop|development|dev)$`}
+	// DefaultReleaseBranches match release branches, where bugs are fixed after the release was cut
+	DefaultReleaseBranches = []string{`^(?:release|releases|rc)[/_-]`}
+	// DefaultMainBranches match production branches, where bugs are hotfixed after the release
+	DefaultMainBranches = []string{`^(?:main|master|production|prod)$`}
+)
+
+// EscapeConfig configures the case-insensitive regexes classifying target branches by release stage
+type EscapeConfig struct {
+	Develop []string `json:"develop,omitempty"` // DefaultDevelopBranches if empty
+	Release []string `json:"release,omitempty"` // DefaultReleaseBranches if empty
+	Main    []string `json:"main,omitempty"`    // DefaultMainBranches if empty
+}
+
+// EscapeAnalyzer classifies bug fixes by the release stage of their target branch.
+// A bug fixed on a release or main branch escaped the development stage.
+type EscapeAnalyzer struct {
+	stages []branchStage
+}
+
+// branchStage is a release stage with the regexes of its branches
+type branchStage struct {
+	stage    string
+	patterns []*regexp.Regexp
+}
+
+// NewEscapeAnalyzer initializes an EscapeAnalyzer from the config (defaults if nil)
+func NewEscapeAnalyzer(cfg *EscapeConfig) (*EscapeAnalyzer, error) {
+	if cfg == nil {
+		cfg = &EscapeConfig{}
+	}
+
+	ea := &EscapeAnalyzer{}
+	for _, stage := range []struct {
+		name     string
+		patterns []string
+		defaults []string
+	}{
+		{StageDevelop, cfg.Develop, DefaultDevelopBranches},
+		{StageRelease, cfg.Release, DefaultReleaseBranches},
+		{StageMain, cfg.Main, DefaultMainBranches},
+	} {
+		patterns := stage.patterns
+		if len(patterns) == 0 {
+			patterns = stage.defaults
+		}
+		compiled, err := compileDetectionPatterns(patterns)
+		if err != nil {
+			return nil, fmt.Errorf("escape.%s: %w", stage.name, err)
+		}
+		ea.stages = append(ea.stages, branchStage{stage: stage.name, patterns: compiled})
+	}
+
+	return ea, nil
+}
+
+// Stage returns the release stage of a target branch, StageOther if no pattern matches.
+// Stages are tried from the earliest to the latest.
+func (ea *EscapeAnalyzer) Stage(branch string) string {
+	for _, stage := range ea.stages {
+		for _, re := range stage.patterns {
+			if re.MatchString(branch) {
+				return stage.stage
+			}
+		}
+	}
+	return StageOther
+}
+
+// IsEscaped reports whether a bug fixed on a branch of this stage was found after the release
+func IsEscaped(stage string) bool {
+	return stage == StageRelease || stage == StageMain
+}
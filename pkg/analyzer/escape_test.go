@@ -0,0 +1,58 @@
+package analyzer
+
+import "testing"
+
+func TestEscapeAnalyzer_Stage(t *testing.T) {
+	ea, err := NewEscapeAnalyzer(nil)
+	if err != nil {
+		t.Fatalf("NewEscapeAnalyzer() error = %v", err)
+	}
+
+	tests := []struct {
+		branch string
+		want   string
+	}{
+		{"develop", StageDevelop},
+		{"Dev", StageDevelop},
+		{"release/1.2.0", StageRelease},
+		{"rc-2024.03", StageRelease},
+		{"main", StageMain},
+		{"master", StageMain},
+		{"feature/login", StageOther},
+		{"develop-old", StageOther},
+	}
+
+	for _, tt := range tests {
+		if got := ea.Stage(tt.branch); got != tt.want {
+			t.Errorf("Stage(%q) = %q, want %q", tt.branch, got, tt.want)
+		}
+	}
+
+	if IsEscaped(StageDevelop) || IsEscaped(StageOther) || !IsEscaped(StageRelease) || !IsEscaped(StageMain) {
+		t.Error("IsEscaped() should be true only for release and main")
+	}
+}
+
+func TestNewEscapeAnalyzer_Config(t *testing.T) {
+	ea, err := NewEscapeAnalyzer(&EscapeConfig{Develop: []string{`^staging$`}, Main: []string{`^hotfix/`}})
+	if err != nil {
+		t.Fatalf("NewEscapeAnalyzer() error = %v", err)
+	}
+
+	if got := ea.Stage("staging"); got != StageDevelop {
+		t.Errorf("Stage(staging) = %q, want %q", got, StageDevelop)
+	}
+	if got := ea.Stage("develop"); got != StageOther {
+		t.Errorf("Stage(develop) = %q, want %q (defaults replaced)", got, StageOther)
+	}
+	if got := ea.Stage("release/2.0"); got != StageRelease {
+		t.Errorf("Stage(release/2.0) = %q, want %q (default kept)", got, StageRelease)
+	}
+	if got := ea.Stage("hotfix/login"); got != StageMain {
+		t.Errorf("Stage(hotfix/login) = %q, want %q", got, StageMain)
+	}
+
+	if _, err := NewEscapeAnalyzer(&EscapeConfig{Release: []string{`(`}}); err == nil {
+		t.Error("NewEscapeAnalyzer() with an invalid regex should fail")
+	}
+}
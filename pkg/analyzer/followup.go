@@ -0,0 +1,169 @@
+package analyzer
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bug-crawler/pkg/platform"
+)
+
+// DefaultFollowUpDays is the window after a merge within which a revert or fix is linked to the merged PR
+const DefaultFollowUpDays = 14
+
+// Kinds of follow-up PRs
+const (
+	FollowUpRevert = "revert"
+	FollowUpFix    = "fix"
+)
+
+var (
+	// revertTitleRegex matches the title of GitHub/Bitbucket revert PRs: Revert "Original title"
+	revertTitleRegex = regexp.MustCompile(`(?i)^\s*revert\s+"(.+)"\s*$`)
+	// revertRefRegex matches "This reverts #123", "Reverts org/repo#123", "revert of PR #12"
+	revertRefRegex = regexp.MustCompile(`(?i)\breverts?\s+(?:of\s+)?(?:(?:pr|pull request)\s*)?(?:([\w.-]+/[\w.-]+))?#(\d+)\b`)
+)
+
+// FollowUpLink links a revert or fix PR to the earlier merged PR it repairs ("caused-by")
+type FollowUpLink struct {
+	PR       *platform.PullRequestData // The revert or fix PR
+	CausedBy *platform.PullRequestData // The original merged PR
+	Kind     string                    // FollowUpRevert or FollowUpFix
+	After    time.Duration             // From the merge of the original PR to the creation of the follow-up
+}
+
+// FollowUpAnalyzer detects revert PRs and bug fixes referencing earlier PRs of the same repository
+type FollowUpAnalyzer struct {
+	days int
+}
+
+// NewFollowUpAnalyzer initializes a FollowUpAnalyzer linking follow-ups created within days of the merge
+// (DefaultFollowUpDays if 0)
+func NewFollowUpAnalyzer(days int) (*FollowUpAnalyzer, error) {
+	if days < 0 {
+		return nil, fmt.Errorf("follow_up_days phải >= 0, nhận %d", days)
+	}
+	if days == 0 {
+		days = DefaultFollowUpDays
+	}
+	return &FollowUpAnalyzer{days: days}, nil
+}
+
+// Days returns the follow-up window in days
+func (fa *FollowUpAnalyzer) Days() int {
+	return fa.days
+}
+
+// IsRevert reports whether a PR reverts another one, from its title (Revert "...", revert: ...) or description
+func IsRevert(pr *platform.PullRequestData) bool {
+	if revertTitleRegex.MatchString(pr.Title) || revertRefRegex.MatchString(pr.Description) {
+		return true
+	}
+	title, ok := ParseConventionalTitle(pr.Title)
+	return ok && title.Type == "revert"
+}
+
+// Analyze links the revert PRs of the results and the fixes to the merged PRs they reference.
+// Fixes are the bug-related results of the scanned bug type, a subset of the results.
+// Only PRs of the results are considered, so originals merged before the scanned range are not found.
+func (fa *FollowUpAnalyzer) Analyze(results, fixes []*BugResult) []*FollowUpLink {
+	isFix := make(map[*platform.PullRequestData]bool, len(fixes))
+	for _, result := range fixes {
+		isFix[result.PR] = true
+	}
+
+	byNumber := make(map[string]map[int]*platform.PullRequestData)
+	byTitle := make(map[string]map[string][]*platform.PullRequestData)
+	for _, result := range results {
+		pr := result.PR
+		if pr.MergedAt == nil {
+			continue
+		}
+		if byNumber[pr.Repository] == nil {
+			byNumber[pr.Repository] = make(map[int]*platform.PullRequestData)
+			byTitle[pr.Repository] = make(map[string][]*platform.PullRequestData)
+		}
+		byNumber[pr.Repository][pr.Number] = pr
+		title := strings.TrimSpace(pr.Title)
+		byTitle[pr.Repository][title] = append(byTitle[pr.Repository][title], pr)
+	}
+
+	window := time.Duration(fa.days) * 24 * time.Hour
+	var links []*FollowUpLink
+	for _, result := range results {
+		pr := result.PR
+		kind := ""
+		var candidates []*platform.PullRequestData
+
+		if IsRevert(pr) {
+			kind = FollowUpRevert
+			for _, number := range revertRefs(pr) {
+				if original, exists := byNumber[pr.Repository][number]; exists {
+					candidates = append(candidates, original)
+				}
+			}
+			if match := revertTitleRegex.FindStringSubmatch(pr.Title); match != nil {
+				candidates = append(candidates, byTitle[pr.Repository][strings.TrimSpace(match[1])]...)
+			}
+		} else if isFix[pr] {
+			kind = FollowUpFix
+			for _, number := range prNumberRefs(pr) {
+				if original, exists := byNumber[pr.Repository][number]; exists {
+					candidates = append(candidates, original)
+				}
+			}
+		}
+
+		linked := make(map[int]bool)
+		for _, original := range candidates {
+			if original.Number == pr.Number || linked[original.Number] {
+				continue
+			}
+			after := pr.CreatedAt.Sub(*original.MergedAt)
+			if after < 0 || after > window {
+				continue
+			}
+			linked[original.Number] = true
+			links = append(links, &FollowUpLink{PR: pr, CausedBy: original, Kind: kind, After: after})
+		}
+	}
+
+	sort.SliceStable(links, func(i, j int) bool {
+		if links[i].PR.Repository != links[j].PR.Repository {
+			return links[i].PR.Repository < links[j].PR.Repository
+		}
+		return links[i].PR.Number < links[j].PR.Number
+	})
+	return links
+}
+
+// revertRefs returns the PR numbers referenced by the revert phrases of a description.
+// References to another repository ("Reverts other/repo#12") are ignored.
+func revertRefs(pr *platform.PullRequestData) []int {
+	var numbers []int
+	for _, match := range revertRefRegex.FindAllStringSubmatch(pr.Description, -1) {
+		if match[1] != "" && !strings.EqualFold(match[1], pr.Repository) {
+			continue
+		}
+		if number, err := strconv.Atoi(match[2]); err == nil {
+			numbers = append(numbers, number)
+		}
+	}
+	return numbers
+}
+
+// prNumberRefs returns the numbers of the "#123" references in the title and description of a PR
+func prNumberRefs(pr *platform.PullRequestData) []int {
+	var numbers []int
+	for _, text := range []string{pr.Title, pr.Description} {
+		for _, match := range issueNumberRegex.FindAllStringSubmatch(text, -1) {
+			if number, err := strconv.Atoi(strings.TrimPrefix(match[1], "#")); err == nil {
+				numbers = append(numbers, number)
+			}
+		}
+	}
+	return numbers
+}
@@ -0,0 +1,77 @@
+package analyzer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bug-crawler/pkg/platform"
+)
+
+func TestIsRevert(t *testing.T) {
+	tests := []struct {
+		pr   *platform.PullRequestData
+		want bool
+	}{
+		{&platform.PullRequestData{Title: `Revert "Add login page"`}, true},
+		{&platform.PullRequestData{Title: "revert: add login page"}, true},
+		{&platform.PullRequestData{Title: "Undo login", Description: "This reverts #12"}, true},
+		{&platform.PullRequestData{Title: "Undo login", Description: "Reverts org/repo#12"}, true},
+		{&platform.PullRequestData{Title: "Revert button color change in settings"}, false},
+		{&platform.PullRequestData{Title: "Fix login", Description: "This reverts commit 1a2b3c"}, false},
+	}
+
+	for _, tt := range tests {
+		if got := IsRevert(tt.pr); got != tt.want {
+			t.Errorf("IsRevert(%q, %q) = %v, want %v", tt.pr.Title, tt.pr.Description, got, tt.want)
+		}
+	}
+}
+
+func TestFollowUpAnalyzer_Analyze(t *testing.T) {
+	base := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	day := func(n int) time.Time { return base.AddDate(0, 0, n) }
+	merged := func(n int) *time.Time { m := day(n); return &m }
+
+	original := &platform.PullRequestData{Repository: "org/repo", Number: 10, Title: "Add login page", Author: "alice", CreatedAt: day(0), MergedAt: merged(1)}
+	old := &platform.PullRequestData{Repository: "org/repo", Number: 11, Title: "Add cache", Author: "bob", CreatedAt: day(0), MergedAt: merged(1)}
+	other := &platform.PullRequestData{Repository: "org/other", Number: 10, Title: "Add login page", Author: "carol", CreatedAt: day(0), MergedAt: merged(1)}
+
+	revert := &platform.PullRequestData{Repository: "org/repo", Number: 20, Title: `Revert "Add login page"`, CreatedAt: day(3), MergedAt: merged(3)}
+	fix := &platform.PullRequestData{Repository: "org/repo", Number: 21, Title: "Fix redirect after login", Description: "Follow-up of #10", CreatedAt: day(5)}
+	lateFix := &platform.PullRequestData{Repository: "org/repo", Number: 22, Title: "Fix cache key", Description: "Regression from #11", CreatedAt: day(30)}
+	notBug := &platform.PullRequestData{Repository: "org/repo", Number: 23, Title: "Docs", Description: "See #10", CreatedAt: day(2)}
+	// Bug-related for another signal than the scanned bug type
+	otherType := &platform.PullRequestData{Repository: "org/repo", Number: 24, Title: "Fix typo", Description: "Typo from #11", CreatedAt: day(2)}
+
+	results := []*BugResult{
+		{PR: original}, {PR: old}, {PR: other}, {PR: revert},
+		{PR: fix, IsBugRelated: true},
+		{PR: lateFix, IsBugRelated: true},
+		{PR: notBug},
+		{PR: otherType, IsBugRelated: true},
+	}
+	fixes := results[4:6]
+
+	fa, err := NewFollowUpAnalyzer(0)
+	if err != nil {
+		t.Fatalf("NewFollowUpAnalyzer() error = %v", err)
+	}
+	if fa.Days() != DefaultFollowUpDays {
+		t.Errorf("Days() = %d, want %d", fa.Days(), DefaultFollowUpDays)
+	}
+
+	links := fa.Analyze(results, fixes)
+	if len(links) != 2 {
+		t.Fatalf("Analyze() = %d links, want 2", len(links))
+	}
+	if links[0].PR != revert || links[0].CausedBy != original || links[0].Kind != FollowUpRevert || links[0].After != 48*time.Hour {
+		t.Errorf("links[0] = %+v, want revert of #10 in org/repo after 48h", links[0])
+	}
+	if links[1].PR != fix || links[1].CausedBy != original || links[1].Kind != FollowUpFix {
+		t.Errorf("links[1] = %+v, want fix of #10", links[1])
+	}
+
+	if _, err := NewFollowUpAnalyzer(-1); err == nil {
+		t.Error("expected error for negative days")
+	}
+}
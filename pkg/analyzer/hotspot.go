@@ -0,0 +1,137 @@
+package analyzer
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// DefaultHotspotDepth is the number of leading path segments used as directory when not configured
+const DefaultHotspotDepth = 2
+
+// Keys used for files without a directory or an extension
+const (
+	HotspotRootDirectory = "(root)"
+	HotspotNoExtension   = "(none)"
+)
+
+// HotspotConfig configures how changed files are grouped into hotspots
+type HotspotConfig struct {
+	Depth   int      `json:"depth,omitempty"`   // Leading directory segments kept, DefaultHotspotDepth if 0
+	Include []string `json:"include,omitempty"` // Globs of the files to keep, all files if empty
+	Exclude []string `json:"exclude,omitempty"` // Globs of the files to leave out, applied after include
+}
+
+// HotspotAnalyzer maps changed files to directory and extension hotspots
+type HotspotAnalyzer struct {
+	depth   int
+	include []*regexp.Regexp
+	exclude []*regexp.Regexp
+}
+
+// NewHotspotAnalyzer initializes a HotspotAnalyzer from the config (defaults if nil)
+func NewHotspotAnalyzer(cfg *HotspotConfig) (*HotspotAnalyzer, error) {
+	if cfg == nil {
+		cfg = &HotspotConfig{}
+	}
+	if cfg.Depth < 0 {
+		return nil, fmt.Errorf("hotspots: depth phải >= 0, nhận %d", cfg.Depth)
+	}
+
+	ha := &HotspotAnalyzer{depth: cfg.Depth}
+	if ha.depth == 0 {
+		ha.depth = DefaultHotspotDepth
+	}
+	for _, glob := range cfg.Include {
+		ha.include = append(ha.include, compilePathGlob(glob))
+	}
+	for _, glob := range cfg.Exclude {
+		ha.exclude = append(ha.exclude, compilePathGlob(glob))
+	}
+
+	return ha, nil
+}
+
+// compilePathGlob converts a path glob to a regexp: "**" matches across directories,
+// "*" and "?" within one path segment. A glob without "/" matches the file name in any directory.
+func compilePathGlob(glob string) *regexp.Regexp {
+	glob = strings.TrimPrefix(glob, "/")
+	if !strings.Contains(glob, "/") {
+		glob = "**/" + glob
+	}
+
+	var b strings.Builder
+	b.WriteString("^")
+	for i := 0; i < len(glob); i++ {
+		switch {
+		case strings.HasPrefix(glob[i:], "**/"):
+			b.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(glob[i:], "**"):
+			b.WriteString(".*")
+			i++
+		case glob[i] == '*':
+			b.WriteString("[^/]*")
+		case glob[i] == '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(glob[i : i+1]))
+		}
+	}
+	b.WriteString("$")
+
+	return regexp.MustCompile(b.String())
+}
+
+// Matches checks if a changed file passes the include and exclude globs
+func (ha *HotspotAnalyzer) Matches(file string) bool {
+	file = strings.TrimPrefix(file, "/")
+	if len(ha.include) > 0 && !matchAny(ha.include, file) {
+		return false
+	}
+	return !matchAny(ha.exclude, file)
+}
+
+func matchAny(patterns []*regexp.Regexp, file string) bool {
+	for _, pattern := range patterns {
+		if pattern.MatchString(file) {
+			return true
+		}
+	}
+	return false
+}
+
+// FilterFiles keeps the changed files passing the include and exclude globs
+func (ha *HotspotAnalyzer) FilterFiles(files []string) []string {
+	filtered := make([]string, 0, len(files))
+	for _, file := range files {
+		if ha.Matches(file) {
+			filtered = append(filtered, file)
+		}
+	}
+	return filtered
+}
+
+// Directory returns the directory prefix of a file, limited to the configured depth
+func (ha *HotspotAnalyzer) Directory(file string) string {
+	dir := path.Dir(strings.TrimPrefix(file, "/"))
+	if dir == "." {
+		return HotspotRootDirectory
+	}
+
+	segments := strings.Split(dir, "/")
+	if len(segments) > ha.depth {
+		segments = segments[:ha.depth]
+	}
+	return strings.Join(segments, "/") + "/"
+}
+
+// Extension returns the lower-cased extension of a file
+func (ha *HotspotAnalyzer) Extension(file string) string {
+	ext := strings.ToLower(path.Ext(file))
+	if ext == "" {
+		return HotspotNoExtension
+	}
+	return ext
+}
@@ -0,0 +1,62 @@
+package analyzer
+
+import "testing"
+
+func TestHotspotAnalyzer_Matches(t *testing.T) {
+	ha, err := NewHotspotAnalyzer(&HotspotConfig{
+		Include: []string{"src/**", "cmd/*.go"},
+		Exclude: []string{"*_test.go", "src/**/vendor/**"},
+	})
+	if err != nil {
+		t.Fatalf("NewHotspotAnalyzer() error = %v", err)
+	}
+
+	tests := []struct {
+		file string
+		want bool
+	}{
+		{"src/api/handler.go", true},
+		{"src/api/handler_test.go", false},
+		{"src/lib/vendor/x/y.go", false},
+		{"cmd/main.go", true},
+		{"cmd/tool/main.go", false},
+		{"docs/readme.md", false},
+	}
+
+	for _, tt := range tests {
+		if got := ha.Matches(tt.file); got != tt.want {
+			t.Errorf("Matches(%q) = %v, want %v", tt.file, got, tt.want)
+		}
+	}
+}
+
+func TestHotspotAnalyzer_DirectoryAndExtension(t *testing.T) {
+	ha, err := NewHotspotAnalyzer(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dirs := map[string]string{
+		"pkg/analyzer/rules.go": "pkg/analyzer/",
+		"pkg/a/b/c/deep.go":     "pkg/a/",
+		"cmd/main.go":           "cmd/",
+		"Makefile":              HotspotRootDirectory,
+		"/web/src/App.TSX":      "web/src/",
+	}
+	for file, want := range dirs {
+		if got := ha.Directory(file); got != want {
+			t.Errorf("Directory(%q) = %q, want %q", file, got, want)
+		}
+	}
+
+	if got := ha.Extension("web/src/App.TSX"); got != ".tsx" {
+		t.Errorf("Extension() = %q, want .tsx", got)
+	}
+	if got := ha.Extension("Makefile"); got != HotspotNoExtension {
+		t.Errorf("Extension() = %q, want %q", got, HotspotNoExtension)
+	}
+
+	if _, err := NewHotspotAnalyzer(&HotspotConfig{Depth: -1}); err == nil {
+		t.Error("Expected error for negative depth")
+	}
+}
@@ -0,0 +1,78 @@
+package analyzer
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/bug-crawler/pkg/platform"
+)
+
+// DefaultIssueBugTypes are the issue types (or labels) of bug issues: GitHub "Bug" type or "bug" label, Backlog "バグ"
+var DefaultIssueBugTypes = []string{"Bug", "バグ"}
+
+var (
+	// issueNumberRegex matches "#123", "closes #45", but not "owner/repo#1" or HTML entities like "&#39;"
+	issueNumberRegex = regexp.MustCompile(`(?:^|[^\w/&])(#\d+)\b`)
+	// issueKeyRegex matches tracker keys like "PROJ-123"; the project key is checked separately
+	issueKeyRegex = regexp.MustCompile(`\b([A-Z][A-Z0-9_]+)-\d+\b`)
+	// issueProjectKeyRegex validates the configured project keys
+	issueProjectKeyRegex = regexp.MustCompile(`^[A-Z][A-Z0-9_]+$`)
+)
+
+// ExtractIssueRefs returns the issue references of a PR found in its title, description and source branch,
+// without duplicates, in order of appearance. Keys like "PROJ-123" are only references if their project
+// is one of the configured issue project keys or of the known ones, e.g. the Backlog project of the PR.
+func (ba *BugAnalyzer) ExtractIssueRefs(pr *platform.PullRequestData, knownProjectKeys ...string) []string {
+	projects := make(map[string]bool)
+	for _, key := range ba.issueProjectKeys {
+		projects[key] = true
+	}
+	for _, key := range knownProjectKeys {
+		projects[strings.ToUpper(key)] = true
+	}
+
+	var refs []string
+	seen := make(map[string]bool)
+	add := func(ref string) {
+		if !seen[ref] {
+			seen[ref] = true
+			refs = append(refs, ref)
+		}
+	}
+
+	for _, text := range []string{pr.Title, pr.Description, pr.SourceBranch} {
+		for _, match := range issueNumberRegex.FindAllStringSubmatch(text, -1) {
+			add(match[1])
+		}
+		for _, match := range issueKeyRegex.FindAllStringSubmatch(text, -1) {
+			if projects[match[1]] {
+				add(match[0])
+			}
+		}
+	}
+
+	return refs
+}
+
+// isBugIssue checks if the type or one of the labels of an issue is a configured bug type
+func (ba *BugAnalyzer) isBugIssue(issue *platform.IssueData) (string, bool) {
+	if ba.issueBugTypes[strings.ToLower(strings.TrimSpace(issue.Type))] {
+		return issue.Type, true
+	}
+	for _, label := range issue.Labels {
+		if ba.issueBugTypes[strings.ToLower(strings.TrimSpace(label))] {
+			return label, true
+		}
+	}
+	return "", false
+}
+
+// matchLinkedIssue returns the first linked issue of a bug type, e.g. "PROJ-12 (Bug)"
+func (ba *BugAnalyzer) matchLinkedIssue(pr *platform.PullRequestData) (string, bool) {
+	for _, issue := range pr.LinkedIssues {
+		if bugType, found := ba.isBugIssue(issue); found {
+			return issue.Key + " (" + bugType + ")", true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,109 @@
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/bug-crawler/pkg/platform"
+)
+
+func TestExtractIssueRefs(t *testing.T) {
+	pr := &platform.PullRequestData{
+		Title:        "PROJ-12: fix login (#34)",
+		Description:  "Closes #45, relates to org/other#7 and PROJ-12.\nDon&#39;t break WEB-3",
+		SourceBranch: "fix/PROJ-99-crash",
+	}
+
+	ba, err := NewBugAnalyzerWithConfig(&BugDetectionConfig{IssueProjectKeys: []string{"web"}})
+	if err != nil {
+		t.Fatalf("NewBugAnalyzerWithConfig failed: %v", err)
+	}
+
+	got := ba.ExtractIssueRefs(pr, "PROJ")
+	want := []string{"#34", "PROJ-12", "#45", "WEB-3", "PROJ-99"}
+	if !equalStrings(got, want) {
+		t.Errorf("ExtractIssueRefs() = %v, want %v", got, want)
+	}
+}
+
+func TestExtractIssueRefs_UnknownProjects(t *testing.T) {
+	pr := &platform.PullRequestData{
+		Title:        "Encode as UTF-8 and hash with SHA-256",
+		Description:  "Dates in ISO-8601, enable HTTP-2. Fixes OPS-7",
+		SourceBranch: "fix/PROJ-99-crash",
+	}
+
+	got := NewBugAnalyzer().ExtractIssueRefs(pr, "PROJ")
+	if want := []string{"PROJ-99"}; !equalStrings(got, want) {
+		t.Errorf("ExtractIssueRefs() = %v, want %v", got, want)
+	}
+	if got := NewBugAnalyzer().ExtractIssueRefs(pr); len(got) != 0 {
+		t.Errorf("ExtractIssueRefs() without project keys = %v, want none", got)
+	}
+}
+
+func TestNewBugAnalyzerWithConfig_InvalidIssueProjectKey(t *testing.T) {
+	if _, err := NewBugAnalyzerWithConfig(&BugDetectionConfig{IssueProjectKeys: []string{"PROJ-"}}); err == nil {
+		t.Error("Expected error for an invalid issue project key")
+	}
+}
+
+func TestAnalyzePR_LinkedIssue(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      *BugDetectionConfig
+		issues      []*platform.IssueData
+		wantBug     bool
+		wantKeyword string
+	}{
+		{
+			name:        "github issue type",
+			issues:      []*platform.IssueData{{Key: "#45", Type: "Bug"}},
+			wantBug:     true,
+			wantKeyword: "#45 (Bug)",
+		},
+		{
+			name:        "github bug label",
+			issues:      []*platform.IssueData{{Key: "#45", Labels: []string{"p1", "bug"}}},
+			wantBug:     true,
+			wantKeyword: "#45 (bug)",
+		},
+		{
+			name:        "backlog issue type, second issue",
+			issues:      []*platform.IssueData{{Key: "PROJ-1", Type: "タスク"}, {Key: "PROJ-2", Type: "バグ"}},
+			wantBug:     true,
+			wantKeyword: "PROJ-2 (バグ)",
+		},
+		{
+			name:    "feature issue",
+			issues:  []*platform.IssueData{{Key: "#45", Type: "Feature", Labels: []string{"bugfix-later"}}},
+			wantBug: false,
+		},
+		{
+			name:        "custom bug types",
+			config:      &BugDetectionConfig{IssueBugTypes: []string{"Defect"}},
+			issues:      []*platform.IssueData{{Key: "PROJ-3", Type: "defect"}},
+			wantBug:     true,
+			wantKeyword: "PROJ-3 (defect)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			analyzer, err := NewBugAnalyzerWithConfig(tt.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			result := analyzer.AnalyzePR(&platform.PullRequestData{LinkedIssues: tt.issues}, "issue", "backlog")
+			if result.IsBugRelated != tt.wantBug {
+				t.Errorf("IsBugRelated = %v, want %v", result.IsBugRelated, tt.wantBug)
+			}
+			if tt.wantBug && result.DetectionType != "linked_issue" {
+				t.Errorf("DetectionType = %q, want linked_issue", result.DetectionType)
+			}
+			if result.MatchedKeyword != tt.wantKeyword {
+				t.Errorf("MatchedKeyword = %q, want %q", result.MatchedKeyword, tt.wantKeyword)
+			}
+		})
+	}
+}
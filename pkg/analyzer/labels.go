@@ -0,0 +1,141 @@
+package analyzer
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// DefaultBugLabels are the PR labels of bug fixes when no label taxonomy is configured.
+// Names match the whole label, so "no-issue" or "prefix" are not bug labels.
+var DefaultBugLabels = []string{
+	"bug", "bugfix", "fix", "hotfix", "critical", "error", "issue", "defect", "regression",
+	`/(?:type|kind)\s*[:/]\s*(?:bug|defect)/`,
+}
+
+// LabelRules lists the labels of bug fixes and the labels never counted as bugs.
+// Entries are exact label names or regexes between slashes ("/bug[-_ ]fix/"), matched against
+// the whole label and case-insensitively.
+type LabelRules struct {
+	Bug     []string `json:"bug,omitempty"`
+	Exclude []string `json:"exclude,omitempty"` // Win over Bug, e.g. "bug-bounty-docs" with Bug "/bug.*/"
+}
+
+// LabelTaxonomyConfig configures the bug labels, globally and per organization ("org") or repository ("org/repo").
+// The most specific entry wins; its empty lists are inherited from the organization, then the global rules.
+type LabelTaxonomyConfig struct {
+	LabelRules
+	Repositories map[string]*LabelRules `json:"repositories,omitempty"`
+}
+
+// labelTaxonomy is the compiled label taxonomy
+type labelTaxonomy struct {
+	global labelRuleSet
+	scoped map[string]labelRuleSet // Keyed by lower-case organization or repository
+}
+
+type labelRuleSet struct {
+	bug     []*regexp.Regexp
+	exclude []*regexp.Regexp
+}
+
+// newLabelTaxonomy compiles a label taxonomy (DefaultBugLabels if nil or without global bug labels)
+func newLabelTaxonomy(cfg *LabelTaxonomyConfig) (*labelTaxonomy, error) {
+	if cfg == nil {
+		cfg = &LabelTaxonomyConfig{}
+	}
+
+	global := cfg.LabelRules
+	if len(global.Bug) == 0 {
+		global.Bug = DefaultBugLabels
+	}
+
+	taxonomy := &labelTaxonomy{scoped: make(map[string]labelRuleSet)}
+	var err error
+	if taxonomy.global, err = compileLabelRules(global); err != nil {
+		return nil, err
+	}
+	for scope, rules := range cfg.Repositories {
+		if rules == nil {
+			continue
+		}
+		compiled, err := compileLabelRules(*rules)
+		if err != nil {
+			return nil, fmt.Errorf("repositories.%s.%w", scope, err)
+		}
+		taxonomy.scoped[strings.ToLower(strings.TrimSpace(scope))] = compiled
+	}
+	return taxonomy, nil
+}
+
+func compileLabelRules(rules LabelRules) (labelRuleSet, error) {
+	bug, err := compileLabelPatterns(rules.Bug)
+	if err != nil {
+		return labelRuleSet{}, fmt.Errorf("bug: %w", err)
+	}
+	exclude, err := compileLabelPatterns(rules.Exclude)
+	if err != nil {
+		return labelRuleSet{}, fmt.Errorf("exclude: %w", err)
+	}
+	return labelRuleSet{bug: bug, exclude: exclude}, nil
+}
+
+// compileLabelPatterns compiles label names and /regex/ entries into anchored, case-insensitive regexes
+func compileLabelPatterns(patterns []string) ([]*regexp.Regexp, error) {
+	compiled := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+		expr := regexp.QuoteMeta(pattern)
+		if len(pattern) > 2 && strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/") {
+			expr = pattern[1 : len(pattern)-1]
+		}
+		re, err := regexp.Compile(`(?i)^\s*(?:` + expr + `)\s*$`)
+		if err != nil {
+			return nil, fmt.Errorf("regex không hợp lệ %q: %w", pattern, err)
+		}
+		compiled = append(compiled, re)
+	}
+	return compiled, nil
+}
+
+// rules returns the label rules of a repository ("org/repo")
+func (lt *labelTaxonomy) rules(repository string) labelRuleSet {
+	rules := lt.global
+	org, _, _ := strings.Cut(repository, "/")
+	for _, scope := range []string{org, repository} {
+		scoped, exists := lt.scoped[strings.ToLower(scope)]
+		if !exists {
+			continue
+		}
+		if len(scoped.bug) > 0 {
+			rules.bug = scoped.bug
+		}
+		if len(scoped.exclude) > 0 {
+			rules.exclude = scoped.exclude
+		}
+	}
+	return rules
+}
+
+// isBug reports whether a label of a repository is a bug label
+func (lt *labelTaxonomy) isBug(repository, label string) bool {
+	rules := lt.rules(repository)
+	return matchAnyRegex(rules.bug, label) && !matchAnyRegex(rules.exclude, label)
+}
+
+func matchAnyRegex(patterns []*regexp.Regexp, text string) bool {
+	for _, re := range patterns {
+		if re.MatchString(text) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsBugLabel reports whether a label marks a PR of the repository ("org/repo") as a bug fix
+func (ba *BugAnalyzer) IsBugLabel(repository, label string) bool {
+	return ba.labels.isBug(repository, label)
+}
@@ -0,0 +1,74 @@
+package analyzer
+
+import "testing"
+
+func TestIsBugLabel_Defaults(t *testing.T) {
+	ba := NewBugAnalyzer()
+
+	tests := []struct {
+		label string
+		want  bool
+	}{
+		{"bug", true},
+		{"Hotfix", true},
+		{"type: bug", true},
+		{"kind/bug", true},
+		{"no-issue", false},
+		{"prefix", false},
+		{"error-budget", false},
+		{"bug-bounty-docs", false},
+	}
+
+	for _, tt := range tests {
+		if got := ba.IsBugLabel("org/repo", tt.label); got != tt.want {
+			t.Errorf("IsBugLabel(%q) = %v, want %v", tt.label, got, tt.want)
+		}
+	}
+}
+
+func TestIsBugLabel_Taxonomy(t *testing.T) {
+	ba, err := NewBugAnalyzerWithConfig(&BugDetectionConfig{
+		Labels: &LabelTaxonomyConfig{
+			LabelRules: LabelRules{
+				Bug:     []string{"bug", "/bug[-_ ].*/"},
+				Exclude: []string{"bug-bounty-docs"},
+			},
+			Repositories: map[string]*LabelRules{
+				"acme":        {Bug: []string{"defect"}},
+				"acme/legacy": {Bug: []string{"/p[0-2]-.*/"}, Exclude: []string{"p2-docs"}},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewBugAnalyzerWithConfig() error = %v", err)
+	}
+
+	tests := []struct {
+		repository string
+		label      string
+		want       bool
+	}{
+		{"other/app", "bug-ui", true},
+		{"other/app", "bug-bounty-docs", false},
+		{"other/app", "defect", false},
+		{"acme/app", "defect", true},
+		{"acme/app", "bug", false},
+		{"ACME/app", "Defect", true},
+		{"acme/legacy", "p1-crash", true},
+		{"acme/legacy", "p2-docs", false},
+		{"acme/legacy", "defect", false},
+	}
+
+	for _, tt := range tests {
+		if got := ba.IsBugLabel(tt.repository, tt.label); got != tt.want {
+			t.Errorf("IsBugLabel(%q, %q) = %v, want %v", tt.repository, tt.label, got, tt.want)
+		}
+	}
+
+	_, err = NewBugAnalyzerWithConfig(&BugDetectionConfig{
+		Labels: &LabelTaxonomyConfig{Repositories: map[string]*LabelRules{"acme": {Bug: []string{"/(/"}}}},
+	})
+	if err == nil {
+		t.Error("NewBugAnalyzerWithConfig() with an invalid label regex should fail")
+	}
+}
@@ -0,0 +1,127 @@
+package analyzer
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+	"golang.org/x/text/width"
+)
+
+// Locales with built-in keywords besides English, which is always checked
+const (
+	LocaleVietnamese = "vi"
+	LocaleJapanese   = "ja"
+)
+
+// localeKeywordPatterns are the regexes of the built-in PR-rules keywords in each locale,
+// e.g. "## Mô tả" or "## 説明" for Description. They are added to rule items with the same keyword.
+var localeKeywordPatterns = map[string]map[string][]string{
+	LocaleVietnamese: {
+		"Description":      {`mô tả|tổng quan`},
+		"Changes Made":     {`nội dung thay đổi|các thay đổi|thay đổi`},
+		"Self-Review":      {`tự review|tự kiểm tra|tự đánh giá`},
+		"Functionality":    {`chức năng|tính năng`},
+		"Security":         {`bảo mật`},
+		"Error Handling":   {`xử lý lỗi`},
+		"Code Style":       {`quy tắc code|phong cách code|coding convention`},
+		"Dependencies":     {`thư viện|phụ thuộc`},
+		"Code Readability": {`dễ đọc|khả năng đọc`},
+	},
+	LocaleJapanese: {
+		"Description":      {`説明|概要`},
+		"Changes Made":     {`変更内容|変更点|変更`},
+		"Self-Review":      {`セルフレビュー|自己レビュー|セルフチェック`},
+		"Functionality":    {`機能|動作確認`},
+		"Security":         {`セキュリティ`},
+		"Error Handling":   {`エラー処理|エラーハンドリング|例外処理`},
+		"Code Style":       {`コードスタイル|コーディング規約`},
+		"Dependencies":     {`依存関係|ライブラリ`},
+		"Code Readability": {`可読性|読みやすさ`},
+	},
+}
+
+// localeTypeBugRegexes match the localized "type: bug" marker of Bitbucket and Backlog descriptions.
+// Full-width colons are folded before matching.
+var localeTypeBugRegexes = map[string]*regexp.Regexp{
+	LocaleVietnamese: regexp.MustCompile(`(?i)loại\s*:\s*(?:bug|lỗi)`),
+	LocaleJapanese:   regexp.MustCompile(`(?i)(?:種別|種類|タイプ)\s*:\s*(?:バグ|不具合)`),
+}
+
+// LocaleConfig selects the locales of the PR templates and descriptions per repository
+type LocaleConfig struct {
+	Default []string `json:"default,omitempty"` // Locales of every repository, e.g. ["vi"]
+	// Repositories overrides Default. Keys are full names or path patterns ("org/repo", "org/*").
+	Repositories map[string][]string `json:"repositories,omitempty"`
+}
+
+// LocaleSelector returns the locales of a repository
+type LocaleSelector struct {
+	defaults     []string
+	repositories map[string][]string
+	patterns     []string // Repository keys in evaluation order
+}
+
+// NewLocaleSelector validates the locale config (English only if nil)
+func NewLocaleSelector(cfg *LocaleConfig) (*LocaleSelector, error) {
+	if cfg == nil {
+		cfg = &LocaleConfig{}
+	}
+
+	ls := &LocaleSelector{repositories: make(map[string][]string)}
+	var err error
+	if ls.defaults, err = validateLocales(cfg.Default); err != nil {
+		return nil, fmt.Errorf("locales.default: %w", err)
+	}
+	for pattern, locales := range cfg.Repositories {
+		if ls.repositories[pattern], err = validateLocales(locales); err != nil {
+			return nil, fmt.Errorf("locales.repositories.%s: %w", pattern, err)
+		}
+		ls.patterns = append(ls.patterns, pattern)
+	}
+	sort.Strings(ls.patterns)
+
+	return ls, nil
+}
+
+// validateLocales lower-cases the locales and rejects the ones without built-in keywords ("en" is accepted and implicit)
+func validateLocales(locales []string) ([]string, error) {
+	valid := make([]string, 0, len(locales))
+	for _, locale := range locales {
+		locale = strings.ToLower(strings.TrimSpace(locale))
+		if locale == "en" {
+			continue
+		}
+		if _, exists := localeKeywordPatterns[locale]; !exists {
+			return nil, fmt.Errorf("locale không hỗ trợ %q (chỉ chấp nhận en, %s, %s)", locale, LocaleVietnamese, LocaleJapanese)
+		}
+		valid = append(valid, locale)
+	}
+	return valid, nil
+}
+
+// For returns the locales of a repository besides English.
+// An exact repository name wins over patterns; patterns are tried in alphabetical order.
+func (ls *LocaleSelector) For(repository string) []string {
+	if ls == nil {
+		return nil
+	}
+	if locales, exists := ls.repositories[repository]; exists {
+		return locales
+	}
+	for _, pattern := range ls.patterns {
+		if matchRepository(pattern, repository) {
+			return ls.repositories[pattern]
+		}
+	}
+	return ls.defaults
+}
+
+// FoldText normalizes a text before keyword matching: Unicode NFC, so that decomposed
+// Vietnamese diacritics match, and width folding (full-width "ＡＢＣ：" to "ABC:", half-width
+// "ｾｷｭﾘﾃｨ" to "セキュリティ"). Case is folded by the case-insensitive regexes.
+func FoldText(text string) string {
+	return norm.NFC.String(width.Fold.String(text))
+}
@@ -0,0 +1,107 @@
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/bug-crawler/pkg/platform"
+)
+
+func TestFoldText(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"種別：バグ", "種別:バグ"},
+		{"## ｾｷｭﾘﾃｨ", "## セキュリティ"},
+		{"ＴＹＰＥ: ＢＵＧ", "TYPE: BUG"},
+		{"Mo\u0302\u0301 ta\u0309", "M\u1ed1 t\u1ea3"},
+	}
+
+	for _, tt := range tests {
+		if got := FoldText(tt.text); got != tt.want {
+			t.Errorf("FoldText(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestLocaleSelector_For(t *testing.T) {
+	ls, err := NewLocaleSelector(&LocaleConfig{
+		Default:      []string{"VI"},
+		Repositories: map[string][]string{"tokyo/*": {"ja"}, "tokyo/global": {"en"}},
+	})
+	if err != nil {
+		t.Fatalf("NewLocaleSelector() error = %v", err)
+	}
+
+	if got := ls.For("hanoi/app"); len(got) != 1 || got[0] != LocaleVietnamese {
+		t.Errorf("For(hanoi/app) = %v, want [vi]", got)
+	}
+	if got := ls.For("tokyo/app"); len(got) != 1 || got[0] != LocaleJapanese {
+		t.Errorf("For(tokyo/app) = %v, want [ja]", got)
+	}
+	if got := ls.For("tokyo/global"); len(got) != 0 {
+		t.Errorf("For(tokyo/global) = %v, want English only", got)
+	}
+
+	if _, err := NewLocaleSelector(&LocaleConfig{Default: []string{"fr"}}); err == nil {
+		t.Error("NewLocaleSelector() with an unsupported locale should fail")
+	}
+}
+
+func TestAnalyzePRRule_Locales(t *testing.T) {
+	ls, err := NewLocaleSelector(&LocaleConfig{Repositories: map[string][]string{"hanoi/*": {"vi"}, "tokyo/*": {"ja"}}})
+	if err != nil {
+		t.Fatalf("NewLocaleSelector() error = %v", err)
+	}
+	pra := NewPRRuleAnalyzer()
+	pra.SetLocales(ls)
+
+	vietnamese := "## Mô tả\nSửa lỗi đăng nhập\n\n## THAY ĐỔI\nCập nhật session\n\n## Bảo mật\nKhông ảnh hưởng\n"
+	japanese := "## 説明\nログイン修正\n\n## 変更内容\nセッション更新\n\n## ｾｷｭﾘﾃｨ\n影響なし\n"
+
+	tests := []struct {
+		repository  string
+		description string
+		want        bool
+	}{
+		{"hanoi/app", vietnamese, true},
+		{"tokyo/app", japanese, true},
+		{"tokyo/app", vietnamese, false},
+		{"other/app", japanese, false},
+	}
+
+	for _, tt := range tests {
+		result := pra.AnalyzePRRule(&platform.PullRequestData{Repository: tt.repository, Description: tt.description})
+		if result.PRDescriptionValid != tt.want {
+			t.Errorf("AnalyzePRRule(%s).PRDescriptionValid = %v, want %v", tt.repository, result.PRDescriptionValid, tt.want)
+		}
+	}
+}
+
+func TestMatchTypeBug_Locales(t *testing.T) {
+	ls, err := NewLocaleSelector(&LocaleConfig{Repositories: map[string][]string{"PROJ/*": {"ja"}, "VN/*": {"vi"}}})
+	if err != nil {
+		t.Fatalf("NewLocaleSelector() error = %v", err)
+	}
+	ba := NewBugAnalyzer()
+	ba.SetLocales(ls)
+
+	tests := []struct {
+		repository  string
+		description string
+		want        bool
+	}{
+		{"PROJ/app", "種別：バグ", true},
+		{"PROJ/app", "種別: 不具合", true},
+		{"VN/app", "Loại: Lỗi", true},
+		{"OTHER/app", "種別: バグ", false},
+		{"OTHER/app", "ＴＹＰＥ：ＢＵＧ", true},
+	}
+
+	for _, tt := range tests {
+		pr := &platform.PullRequestData{Repository: tt.repository, Description: tt.description}
+		if got := ba.AnalyzePR(pr, "bug", "backlog").IsBugRelated; got != tt.want {
+			t.Errorf("AnalyzePR(%s, %q).IsBugRelated = %v, want %v", tt.repository, tt.description, got, tt.want)
+		}
+	}
+}
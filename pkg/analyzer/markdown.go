@@ -0,0 +1,155 @@
+package analyzer
+
+import (
+	"regexp"
+	"strings"
+)
+
+// MarkdownSection is a heading of a Markdown document and the content below it
+type MarkdownSection struct {
+	Heading string // Heading text without the leading #'s
+	Level   int    // 1 for "#", 2 for "##", ...
+	Line    string // Heading line as written
+	Start   int    // Byte offset of the heading line in the document
+	End     int    // Byte offset of the end of the heading line
+	Content string // Everything until the next heading of the same or a higher level
+}
+
+var (
+	atxHeadingRegex      = regexp.MustCompile(`^ {0,3}(#{1,6})(?:[ \t]+(.*?))?[ \t]*#*[ \t]*$`)
+	fenceRegex           = regexp.MustCompile("^ {0,3}(```|~~~)")
+	htmlCommentRegex     = regexp.MustCompile(`(?s)<!--.*?-->`)
+	listMarkerRegex      = regexp.MustCompile(`^(?:[-*+]|\d+[.)])\s+`)
+	taskMarkerRegex      = regexp.MustCompile(`^\[[ xX]\]\s*`)
+	bracketedPlaceholder = regexp.MustCompile(`^\[[^\]]*\]:?$`)
+	headingPrefixRegex   = regexp.MustCompile(`^(?:[^\p{L}\p{N}]+|\d+[.)]\s*)+`)
+)
+
+// placeholderLines are lines that don't count as real section content
+var placeholderLines = map[string]bool{
+	"":     true,
+	"-":    true,
+	"...":  true,
+	"…":    true,
+	"tbd":  true,
+	"todo": true,
+	"xxx":  true,
+}
+
+// ParseMarkdownSections splits a Markdown document into sections by its ATX headings ("## Title").
+// Headings inside fenced code blocks are ignored.
+func ParseMarkdownSections(text string) []*MarkdownSection {
+	type heading struct {
+		section *MarkdownSection
+		bodyAt  int // Byte offset where the content starts
+	}
+
+	var headings []heading
+	inFence := false
+	offset := 0
+
+	for _, line := range strings.SplitAfter(text, "\n") {
+		lineStart := offset
+		offset += len(line)
+		trimmed := strings.TrimRight(line, "\r\n")
+
+		if fenceRegex.MatchString(trimmed) {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+
+		matches := atxHeadingRegex.FindStringSubmatch(trimmed)
+		if matches == nil {
+			continue
+		}
+
+		headings = append(headings, heading{
+			section: &MarkdownSection{
+				Heading: strings.TrimSpace(matches[2]),
+				Level:   len(matches[1]),
+				Line:    strings.TrimSpace(trimmed),
+				Start:   lineStart,
+				End:     lineStart + len(trimmed),
+			},
+			bodyAt: offset,
+		})
+	}
+
+	sections := make([]*MarkdownSection, 0, len(headings))
+	for i, h := range headings {
+		contentEnd := len(text)
+		for _, next := range headings[i+1:] {
+			if next.section.Level <= h.section.Level {
+				contentEnd = next.section.Start
+				break
+			}
+		}
+		h.section.Content = text[h.bodyAt:contentEnd]
+		sections = append(sections, h.section)
+	}
+
+	return sections
+}
+
+// HasRealContent checks whether a section body contains more than template placeholders:
+// empty lines, HTML comments, bracketed hints like "[Mô tả ngắn gọn]", empty list or task items, "TBD".
+func HasRealContent(content string) bool {
+	content = htmlCommentRegex.ReplaceAllString(content, "")
+
+	inFence := false
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+
+		if fenceRegex.MatchString(line) {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			if line != "" {
+				return true
+			}
+			continue
+		}
+
+		if atxHeadingRegex.MatchString(line) {
+			continue
+		}
+
+		line = listMarkerRegex.ReplaceAllString(line, "")
+		line = taskMarkerRegex.ReplaceAllString(line, "")
+		line = strings.TrimSpace(line)
+
+		if placeholderLines[strings.ToLower(line)] || bracketedPlaceholder.MatchString(line) {
+			continue
+		}
+		return true
+	}
+
+	return false
+}
+
+// normalizeHeading strips decorations before the heading text (emoji, numbering, punctuation)
+func normalizeHeading(heading string) string {
+	return strings.TrimSpace(headingPrefixRegex.ReplaceAllString(heading, ""))
+}
+
+// compileHeadingPattern builds a regex matching headings that start with the pattern as a whole word
+func compileHeadingPattern(pattern string) (*regexp.Regexp, error) {
+	return regexp.Compile(`(?i)^(?:` + pattern + `)(?:$|[^\p{L}\p{N}_])`)
+}
+
+// findSection returns the first section whose heading matches the item
+func (item *compiledRuleItem) findSection(sections []*MarkdownSection) (*MarkdownSection, bool) {
+	for _, section := range sections {
+		heading := normalizeHeading(section.Heading)
+		for _, pattern := range item.headingPatterns {
+			if pattern.MatchString(heading) {
+				return section, true
+			}
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,115 @@
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/bug-crawler/pkg/platform"
+)
+
+func TestParseMarkdownSections(t *testing.T) {
+	text := "Intro\n## Description\nAdd login.\n### Notes\nnested\n## Security ##\n```\n## not a heading\n```\n# Dependencies\n"
+
+	sections := ParseMarkdownSections(text)
+
+	want := []struct {
+		heading string
+		level   int
+		content string
+	}{
+		{"Description", 2, "Add login.\n### Notes\nnested\n"},
+		{"Notes", 3, "nested\n"},
+		{"Security", 2, "```\n## not a heading\n```\n"},
+		{"Dependencies", 1, ""},
+	}
+
+	if len(sections) != len(want) {
+		t.Fatalf("Got %d sections, want %d: %+v", len(sections), len(want), sections)
+	}
+	for i, w := range want {
+		if sections[i].Heading != w.heading || sections[i].Level != w.level || sections[i].Content != w.content {
+			t.Errorf("Section %d = %+v, want %+v", i, sections[i], w)
+		}
+		if text[sections[i].Start:sections[i].End] != sections[i].Line {
+			t.Errorf("Section %d offsets do not match line %q", i, sections[i].Line)
+		}
+	}
+}
+
+func TestHasRealContent(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    bool
+	}{
+		{name: "empty", content: "\n\n", want: false},
+		{name: "bracketed placeholder", content: "[Mô tả ngắn gọn về mục đích của PR này]\n", want: false},
+		{name: "placeholder list", content: "- [Thay đổi 1]\n- [Thay đổi 2]\n", want: false},
+		{name: "html comment", content: "<!-- Mô tả thay đổi -->\n", want: false},
+		{name: "empty task items", content: "- [ ]\n- [x]\n", want: false},
+		{name: "tbd", content: "TBD\n", want: false},
+		{name: "sub heading only", content: "### Notes\n", want: false},
+		{name: "text", content: "Thêm tính năng 2FA\n", want: true},
+		{name: "placeholder then text", content: "[Đánh giá bảo mật]\n- Đã validate input\n", want: true},
+		{name: "task item with text", content: "- [ ] Code đã được test\n", want: true},
+		{name: "code block", content: "```\ngo test ./...\n```\n", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HasRealContent(tt.content); got != tt.want {
+				t.Errorf("HasRealContent(%q) = %v, want %v", tt.content, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnalyzePRRule_EmptySections(t *testing.T) {
+	analyzer := NewPRRuleAnalyzer()
+
+	result := analyzer.AnalyzePRRule(&platform.PullRequestData{
+		Description: "## Description\nAdd 2FA\n## Changes Made\n- [Thay đổi 1]\n## Security\n<!-- TODO -->\n## Functionality\nTBD\n",
+	})
+
+	if result.PRDescriptionValid {
+		t.Error("Expected description with placeholder sections to be invalid")
+	}
+	if got := result.RuleResults[0].Empty; !equalStrings(got, []string{"Changes Made", "Functionality", "Security"}) {
+		t.Errorf("Empty = %v, want [Changes Made Functionality Security]", got)
+	}
+}
+
+func TestAnalyzePRRule_KeywordsMatchMode(t *testing.T) {
+	analyzer, err := NewPRRuleAnalyzerWithConfig(&RuleConfig{RuleSets: []RuleSet{{
+		Name:       "legacy",
+		Target:     RuleTargetDescription,
+		Match:      RuleMatchKeywords,
+		MinMatches: 1,
+		Items:      []RuleItem{{Keyword: "Testing"}},
+	}}})
+	if err != nil {
+		t.Fatalf("NewPRRuleAnalyzerWithConfig failed: %v", err)
+	}
+
+	result := analyzer.AnalyzePRRule(&platform.PullRequestData{Description: "Testing: unit tests"})
+	if !result.PRDescriptionValid {
+		t.Error("Expected keyword match mode to accept keywords anywhere in the text")
+	}
+
+	if _, err := NewPRRuleAnalyzerWithConfig(&RuleConfig{RuleSets: []RuleSet{{
+		Name: "bad", Target: RuleTargetDescription, Match: "headings", Items: []RuleItem{{Keyword: "X"}},
+	}}}); err == nil {
+		t.Error("Expected error for invalid match mode")
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
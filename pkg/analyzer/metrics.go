@@ -0,0 +1,91 @@
+package analyzer
+
+import (
+	"strings"
+	"time"
+
+	"github.com/bug-crawler/pkg/platform"
+)
+
+// PRMetrics contains the lead-time metrics of a PR.
+// Durations are nil when the event didn't happen (no review, not approved, not merged).
+type PRMetrics struct {
+	PR                *platform.PullRequestData
+	TimeToFirstReview *time.Duration // Creation to the first review or comment by someone other than the author
+	TimeToApproval    *time.Duration // Creation to the first approval
+	TimeToMerge       *time.Duration // Creation to merge
+	ReviewRounds      int            // 1 + number of change requests, 0 without any review
+}
+
+// MetricsAnalyzer computes lead-time metrics of PRs
+type MetricsAnalyzer struct {
+	bots *BotMatcher // Accounts whose reviews are ignored
+}
+
+// NewMetricsAnalyzer initializes a MetricsAnalyzer ignoring reviews from the given bots (DefaultBotMatcher if nil)
+func NewMetricsAnalyzer(bots *BotMatcher) *MetricsAnalyzer {
+	if bots == nil {
+		bots = DefaultBotMatcher
+	}
+	return &MetricsAnalyzer{bots: bots}
+}
+
+// AnalyzePR computes the lead-time metrics of a PR
+func (ma *MetricsAnalyzer) AnalyzePR(pr *platform.PullRequestData) *PRMetrics {
+	metrics := &PRMetrics{PR: pr}
+
+	var firstReview, firstApproval *time.Time
+	changeRequests := 0
+	reviewed := false
+
+	for _, review := range pr.Reviews {
+		if review.ReviewerLogin == "" || strings.EqualFold(review.ReviewerLogin, pr.Author) || ma.bots.IsBot(review.ReviewerLogin) {
+			continue
+		}
+		reviewed = true
+
+		if review.State == ReviewStateChangesRequested {
+			changeRequests++
+		}
+
+		// Reviews are not always listed in chronological order (Backlog returns the latest first)
+		if review.SubmittedAt == nil || review.SubmittedAt.Before(pr.CreatedAt) {
+			continue
+		}
+		if firstReview == nil || review.SubmittedAt.Before(*firstReview) {
+			firstReview = review.SubmittedAt
+		}
+		if review.State == ReviewStateApproved && (firstApproval == nil || review.SubmittedAt.Before(*firstApproval)) {
+			firstApproval = review.SubmittedAt
+		}
+	}
+
+	metrics.TimeToFirstReview = durationSince(pr.CreatedAt, firstReview)
+	metrics.TimeToApproval = durationSince(pr.CreatedAt, firstApproval)
+	if pr.MergedAt != nil && !pr.MergedAt.Before(pr.CreatedAt) {
+		metrics.TimeToMerge = durationSince(pr.CreatedAt, pr.MergedAt)
+	}
+	if reviewed {
+		metrics.ReviewRounds = 1 + changeRequests
+	}
+
+	return metrics
+}
+
+// AnalyzePRs computes the lead-time metrics of a list of PRs
+func (ma *MetricsAnalyzer) AnalyzePRs(prs []*platform.PullRequestData) []*PRMetrics {
+	results := make([]*PRMetrics, 0, len(prs))
+	for _, pr := range prs {
+		results = append(results, ma.AnalyzePR(pr))
+	}
+	return results
+}
+
+// durationSince returns the duration from start to end, nil if end is nil
+func durationSince(start time.Time, end *time.Time) *time.Duration {
+	if end == nil {
+		return nil
+	}
+	d := end.Sub(start)
+	return &d
+}
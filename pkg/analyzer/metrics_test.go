@@ -0,0 +1,105 @@
+package analyzer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bug-crawler/pkg/platform"
+)
+
+func TestMetricsAnalyzer_AnalyzePR(t *testing.T) {
+	created := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	at := func(hours int) *time.Time {
+		t := created.Add(time.Duration(hours) * time.Hour)
+		return &t
+	}
+	hours := func(d *time.Duration) float64 {
+		if d == nil {
+			return -1
+		}
+		return d.Hours()
+	}
+
+	tests := []struct {
+		name            string
+		pr              *platform.PullRequestData
+		wantFirstReview float64
+		wantApproval    float64
+		wantMerge       float64
+		wantRounds      int
+	}{
+		{
+			name: "reviews out of order, author and bots ignored",
+			pr: &platform.PullRequestData{
+				Author:    "author",
+				CreatedAt: created,
+				MergedAt:  at(30),
+				Reviews: []*platform.ReviewData{
+					{ReviewerLogin: "r1", State: ReviewStateApproved, SubmittedAt: at(20)},
+					{ReviewerLogin: "r2", State: ReviewStateChangesRequested, SubmittedAt: at(5)},
+					{ReviewerLogin: "author", State: ReviewStateCommented, SubmittedAt: at(1)},
+					{ReviewerLogin: "ci[bot]", State: ReviewStateCommented, SubmittedAt: at(0)},
+				},
+			},
+			wantFirstReview: 5,
+			wantApproval:    20,
+			wantMerge:       30,
+			wantRounds:      2,
+		},
+		{
+			name:            "no review, not merged",
+			pr:              &platform.PullRequestData{Author: "author", CreatedAt: created},
+			wantFirstReview: -1,
+			wantApproval:    -1,
+			wantMerge:       -1,
+			wantRounds:      0,
+		},
+		{
+			name: "review without timestamp counts as round only",
+			pr: &platform.PullRequestData{
+				Author:    "author",
+				CreatedAt: created,
+				Reviews:   []*platform.ReviewData{{ReviewerLogin: "r1", State: ReviewStateCommented}},
+			},
+			wantFirstReview: -1,
+			wantApproval:    -1,
+			wantMerge:       -1,
+			wantRounds:      1,
+		},
+		{
+			// Bitbucket participant approvals carry no approval time
+			name: "approval without timestamp is skipped",
+			pr: &platform.PullRequestData{
+				Author:    "author",
+				CreatedAt: created,
+				Reviews: []*platform.ReviewData{
+					{ReviewerLogin: "r1", State: ReviewStateCommented, SubmittedAt: at(2)},
+					{ReviewerLogin: "r1", State: ReviewStateApproved},
+				},
+			},
+			wantFirstReview: 2,
+			wantApproval:    -1,
+			wantMerge:       -1,
+			wantRounds:      1,
+		},
+	}
+
+	ma := NewMetricsAnalyzer(nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metrics := ma.AnalyzePR(tt.pr)
+			if got := hours(metrics.TimeToFirstReview); got != tt.wantFirstReview {
+				t.Errorf("TimeToFirstReview = %v, want %v", got, tt.wantFirstReview)
+			}
+			if got := hours(metrics.TimeToApproval); got != tt.wantApproval {
+				t.Errorf("TimeToApproval = %v, want %v", got, tt.wantApproval)
+			}
+			if got := hours(metrics.TimeToMerge); got != tt.wantMerge {
+				t.Errorf("TimeToMerge = %v, want %v", got, tt.wantMerge)
+			}
+			if metrics.ReviewRounds != tt.wantRounds {
+				t.Errorf("ReviewRounds = %d, want %d", metrics.ReviewRounds, tt.wantRounds)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/bug-crawler/pkg/platform"
+)
+
+// Reviewer modes: how the per-reviewer results of a review rule set are combined
+const (
+	ReviewerModeAny      = "any"      // At least one reviewer passes (default)
+	ReviewerModeAll      = "all"      // Every reviewer passes
+	ReviewerModeMajority = "majority" // More than half of the reviewers pass
+)
+
+// ReviewerRuleResult contains the evaluation of a review rule set against the comments of one reviewer
+type ReviewerRuleResult struct {
+	Reviewer     string
+	Passed       bool
+	ApproveOnly  bool // Reviewer has no comment (approvals only); not counted by the reviewer mode
+	MatchedCount int
+	Matched      []KeywordMatch
+	Missing      []string
+}
+
+// groupReviewsByReviewer groups reviews by reviewer, leaving out the PR author and bots.
+// Reviewers are returned in order of their first review.
+func groupReviewsByReviewer(reviews []*platform.ReviewData, author string, bots *BotMatcher) ([]string, map[string][]*platform.ReviewData) {
+	var reviewers []string
+	byReviewer := make(map[string][]*platform.ReviewData)
+
+	for _, review := range reviews {
+		login := review.ReviewerLogin
+		if login == "" || (author != "" && strings.EqualFold(login, author)) || bots.IsBot(login) {
+			continue
+		}
+		if _, exists := byReviewer[login]; !exists {
+			reviewers = append(reviewers, login)
+		}
+		byReviewer[login] = append(byReviewer[login], review)
+	}
+
+	return reviewers, byReviewer
+}
+
+// evaluateReviewers checks the rule set against the comments of every reviewer separately
+// and combines the results according to the reviewer mode. Reviewers without any comment
+// (approve-only) are reported but not counted; it never passes without commenting reviewers.
+func (rs *compiledRuleSet) evaluateReviewers(reviews []*platform.ReviewData, author string, bots *BotMatcher) *RuleResult {
+	result := &RuleResult{
+		Name:       rs.Name,
+		Target:     rs.Target,
+		MinMatches: rs.MinMatches,
+		Matched:    make([]KeywordMatch, 0),
+		Missing:    make([]string, 0),
+		Empty:      make([]string, 0),
+		Reviewers:  make([]*ReviewerRuleResult, 0),
+	}
+
+	reviewers, byReviewer := groupReviewsByReviewer(reviews, author, bots)
+
+	matchedKeywords := make(map[string]bool)
+	evaluated, passedCount := 0, 0
+	for _, reviewer := range reviewers {
+		comments := aggregateReviewComments(byReviewer[reviewer])
+		reviewerResult := rs.evaluate(comments)
+		// A reviewer without any comment can't pass, even if the rule set requires no keyword
+		approveOnly := comments == ""
+		passed := reviewerResult.Passed && !approveOnly
+		if !approveOnly {
+			evaluated++
+		}
+		if passed {
+			passedCount++
+		}
+
+		result.Reviewers = append(result.Reviewers, &ReviewerRuleResult{
+			Reviewer:     reviewer,
+			Passed:       passed,
+			ApproveOnly:  approveOnly,
+			MatchedCount: reviewerResult.MatchedCount,
+			Matched:      reviewerResult.Matched,
+			Missing:      reviewerResult.Missing,
+		})
+
+		for _, match := range reviewerResult.Matched {
+			if !matchedKeywords[match.Keyword] {
+				matchedKeywords[match.Keyword] = true
+				result.Matched = append(result.Matched, match)
+			}
+		}
+	}
+
+	// Keywords no reviewer mentioned, in rule set order
+	for _, item := range rs.items {
+		if !matchedKeywords[item.Keyword] {
+			result.Missing = append(result.Missing, item.Keyword)
+		}
+	}
+	result.MatchedCount = len(matchedKeywords)
+
+	switch rs.Reviewers {
+	case ReviewerModeAll:
+		result.Passed = evaluated > 0 && passedCount == evaluated
+	case ReviewerModeMajority:
+		result.Passed = passedCount*2 > evaluated
+	default:
+		result.Passed = passedCount > 0
+	}
+
+	return result
+}
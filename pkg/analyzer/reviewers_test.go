@@ -0,0 +1,129 @@
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/bug-crawler/pkg/platform"
+)
+
+func TestAnalyzePRRule_ReviewerModes(t *testing.T) {
+	reviewRule := func(mode string) *RuleConfig {
+		return &RuleConfig{
+			RuleSets: []RuleSet{{
+				Name:       "review",
+				Target:     RuleTargetReview,
+				MinMatches: 2,
+				Reviewers:  mode,
+				Items:      []RuleItem{{Keyword: "Functionality"}, {Keyword: "Security"}, {Keyword: "Code Style"}},
+			}},
+		}
+	}
+
+	thorough := &platform.ReviewData{ReviewerLogin: "r1", CommentBody: "Functionality OK, Security OK"}
+	lazy := &platform.ReviewData{ReviewerLogin: "r2", CommentBody: "LGTM"}
+	lazyApproval := &platform.ReviewData{ReviewerLogin: "r3", State: ReviewStateApproved}
+	secondThorough := &platform.ReviewData{ReviewerLogin: "r4", CommentBody: "Security and code style checked"}
+
+	tests := []struct {
+		name      string
+		config    *RuleConfig
+		bots      []string // Bot patterns replacing the defaults
+		reviews   []*platform.ReviewData
+		wantValid bool
+	}{
+		{name: "any passes with one thorough reviewer", config: reviewRule(""), reviews: []*platform.ReviewData{thorough, lazy}, wantValid: true},
+		{name: "all fails with one lazy reviewer", config: reviewRule(ReviewerModeAll), reviews: []*platform.ReviewData{thorough, lazy}, wantValid: false},
+		{name: "all passes", config: reviewRule(ReviewerModeAll), reviews: []*platform.ReviewData{thorough, secondThorough}, wantValid: true},
+		{name: "majority fails on a tie", config: reviewRule(ReviewerModeMajority), reviews: []*platform.ReviewData{thorough, lazy}, wantValid: false},
+		{name: "majority passes", config: reviewRule(ReviewerModeMajority), reviews: []*platform.ReviewData{thorough, secondThorough, lazyApproval}, wantValid: true},
+		{name: "all ignores approve-only reviewers", config: reviewRule(ReviewerModeAll), reviews: []*platform.ReviewData{thorough, lazyApproval}, wantValid: true},
+		{name: "majority ignores approve-only reviewers", config: reviewRule(ReviewerModeMajority), reviews: []*platform.ReviewData{thorough, lazyApproval}, wantValid: true},
+		{name: "approve-only reviewers alone don't pass", config: reviewRule(ReviewerModeAll), reviews: []*platform.ReviewData{lazyApproval}, wantValid: false},
+		{
+			name:      "author comments don't count",
+			config:    reviewRule(""),
+			reviews:   []*platform.ReviewData{{ReviewerLogin: "Author", CommentBody: "Functionality and security handled"}, lazy},
+			wantValid: false,
+		},
+		{
+			name:      "default bots don't count",
+			config:    reviewRule(ReviewerModeAll),
+			reviews:   []*platform.ReviewData{thorough, {ReviewerLogin: "sonarcloud[bot]", CommentBody: "Quality gate passed"}},
+			wantValid: true,
+		},
+		{
+			name:      "configured bots don't count",
+			config:    reviewRule(ReviewerModeAll),
+			bots:      []string{"ci-*"},
+			reviews:   []*platform.ReviewData{thorough, {ReviewerLogin: "CI-Runner", CommentBody: "Build passed"}},
+			wantValid: true,
+		},
+		{name: "no reviewers", config: reviewRule(ReviewerModeMajority), reviews: nil, wantValid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			analyzer, err := NewPRRuleAnalyzerWithConfig(tt.config)
+			if err != nil {
+				t.Fatalf("NewPRRuleAnalyzerWithConfig failed: %v", err)
+			}
+			if tt.bots != nil {
+				bots, err := NewBotMatcher(tt.bots)
+				if err != nil {
+					t.Fatalf("NewBotMatcher failed: %v", err)
+				}
+				analyzer.SetBots(bots)
+			}
+
+			result := analyzer.AnalyzePRRule(&platform.PullRequestData{Author: "author", Reviews: tt.reviews})
+			if result.ReviewCommentValid != tt.wantValid {
+				t.Errorf("ReviewCommentValid = %v, want %v", result.ReviewCommentValid, tt.wantValid)
+			}
+		})
+	}
+}
+
+func TestAnalyzePRRule_ReviewerBreakdown(t *testing.T) {
+	analyzer := NewPRRuleAnalyzer()
+
+	result := analyzer.AnalyzePRRule(&platform.PullRequestData{
+		Author: "author",
+		Reviews: []*platform.ReviewData{
+			{ReviewerLogin: "r1", CommentBody: "F1 OK"},
+			{ReviewerLogin: "r2", CommentBody: "LGTM"},
+			{ReviewerLogin: "r3", State: ReviewStateApproved},
+			{ReviewerLogin: "r1", CommentBody: "S1 OK, EH1 OK"},
+			{ReviewerLogin: "author", CommentBody: "Code style fixed"},
+		},
+	})
+
+	reviewers := result.RuleResults[1].Reviewers
+	if len(reviewers) != 3 {
+		t.Fatalf("Got %d reviewer results, want 3", len(reviewers))
+	}
+	if reviewers[0].Reviewer != "r1" || !reviewers[0].Passed || reviewers[0].MatchedCount != 3 {
+		t.Errorf("Reviewer r1 = %+v", reviewers[0])
+	}
+	if reviewers[1].Reviewer != "r2" || reviewers[1].Passed || reviewers[1].ApproveOnly || len(reviewers[1].Missing) != 5 {
+		t.Errorf("Reviewer r2 = %+v", reviewers[1])
+	}
+	if reviewers[2].Reviewer != "r3" || reviewers[2].Passed || !reviewers[2].ApproveOnly {
+		t.Errorf("Reviewer r3 = %+v", reviewers[2])
+	}
+	// Code Style was only mentioned by the author
+	if missing := result.RuleResults[1].Missing; !equalStrings(missing, []string{"Code Style", "Code Readability"}) {
+		t.Errorf("Missing = %v", missing)
+	}
+}
+
+func TestNewPRRuleAnalyzerWithConfig_InvalidReviewers(t *testing.T) {
+	configs := []*RuleConfig{
+		{RuleSets: []RuleSet{{Name: "review", Target: RuleTargetReview, Reviewers: "most", Items: []RuleItem{{Keyword: "X"}}}}},
+	}
+
+	for _, config := range configs {
+		if _, err := NewPRRuleAnalyzerWithConfig(config); err == nil {
+			t.Errorf("Expected error for config %+v", config)
+		}
+	}
+}
@@ -0,0 +1,456 @@
+package analyzer
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// Rule targets: which part of the PR a rule set is checked against
+const (
+	RuleTargetDescription = "description"
+	RuleTargetReview      = "review"
+	RuleTargetApproval    = "approval"
+)
+
+// Rule match modes: how the items of a rule set are looked up in the text
+const (
+	RuleMatchSections  = "sections"  // Markdown headings with real content (default for description rule sets)
+	RuleMatchKeywords  = "keywords"  // Keyword or pattern anywhere in the text (default for review rule sets)
+	RuleMatchChecklist = "checklist" // Task list items ("- [ ]" / "- [x]") under the Markdown headings of the items
+)
+
+// Default rule set names
+const (
+	DefaultDescriptionRuleSet = "description_keywords"
+	DefaultReviewRuleSet      = "review_keywords"
+)
+
+// descriptionKeywordPatterns defines regular expressions for specific description keywords and their abbreviated forms.
+// This allows for flexible matching beyond exact substring comparison.
+var descriptionKeywordPatterns = map[string][]string{
+	"Description":    {`description|desc|d\d`},
+	"Changes Made":   {`changes made|changes|cm\d|change\d`},
+	"Self-Review":    {`self-review|self review|sr\d`},
+	"Functionality":  {`functionality|f\d`},
+	"Security":       {`security|s\d`},
+	"Error Handling": {`error handling|eh\d`},
+	"Code Style":     {`code style|readability|c\d`},
+	"Dependencies":   {`dependencies|dep\d`},
+}
+
+// reviewKeywordPatterns defines regular expressions for review comment keywords and their abbreviated tags
+var reviewKeywordPatterns = map[string][]string{
+	"Functionality":    {`functionality|f\d`},
+	"Security":         {`security|s\d`},
+	"Error Handling":   {`error handling|eh\d`},
+	"Code Style":       {`code style|c\d`},
+	"Code Readability": {`readability|code readability|cr\d`},
+}
+
+// RuleItem is one keyword checked by a rule set
+type RuleItem struct {
+	Keyword  string   `json:"keyword"`
+	Patterns []string `json:"patterns,omitempty"` // Alternative regexes (case-insensitive); substring match on Keyword if empty
+	Required bool     `json:"required,omitempty"` // The rule set fails if a required item is missing, whatever the count
+}
+
+// RuleSet is a named group of keywords checked against one part of a PR
+type RuleSet struct {
+	Name       string     `json:"name"`
+	Target     string     `json:"target"`          // "description", "review" or "approval"
+	Match      string     `json:"match,omitempty"` // "sections", "keywords" or "checklist", defaults depend on Target
+	Items      []RuleItem `json:"items"`
+	MinMatches int        `json:"min_matches"`
+	// Checklist rule sets only: require every item checked, or at least MinCheckedPercent of them.
+	// Without either option the checklist is reported but never fails.
+	RequireAll        bool    `json:"require_all,omitempty"`
+	MinCheckedPercent float64 `json:"min_checked_percent,omitempty"`
+	// Approval rule sets only: approvals required from reviewers other than the author,
+	// and whether an outstanding change request fails the rule set.
+	MinApprovals          int  `json:"min_approvals,omitempty"`
+	BlockChangesRequested bool `json:"block_changes_requested,omitempty"`
+	// Review rule sets only: "any" (default), "all" or "majority" of the reviewers must pass
+	Reviewers string `json:"reviewers,omitempty"`
+	Disabled  bool   `json:"disabled,omitempty"` // Used in repository overrides to turn a rule set off
+}
+
+// RuleConfig contains the rule sets evaluated by PRRuleAnalyzer
+type RuleConfig struct {
+	RuleSets []RuleSet `json:"rule_sets"`
+	// Repositories overrides rule sets per repository. Keys are full names or path patterns
+	// ("org/repo", "org/*"); a rule set replaces the default one with the same name or is added.
+	Repositories map[string][]RuleSet `json:"repositories,omitempty"`
+}
+
+// KeywordMatch describes where a keyword was found in the checked text
+type KeywordMatch struct {
+	Keyword string
+	Span    string // Text matched by the keyword or one of its patterns
+	Start   int    // Byte offset of the span in the checked text, after FoldText
+	End     int
+	Context string // Line containing the span, to explain why it matched
+}
+
+// KeywordCheckResult contains the explained result of checking keywords in a text
+type KeywordCheckResult struct {
+	Valid   bool
+	Matched []KeywordMatch
+	Missing []string
+}
+
+// RuleResult contains the evaluation result of one rule set
+type RuleResult struct {
+	Name         string
+	Target       string
+	Passed       bool
+	MatchedCount int
+	MinMatches   int
+	Matched      []KeywordMatch
+	Missing      []string              // Keywords not found, in rule set order
+	Empty        []string              // Keywords whose section exists but only has placeholder content (also in Missing)
+	Checklist    *ChecklistResult      // Task list items, only for checklist rule sets
+	Approval     *ApprovalResult       // Approval status, only for approval rule sets
+	Reviewers    []*ReviewerRuleResult // Per-reviewer results, only for review rule sets
+}
+
+// maxContextLength limits the length of KeywordMatch.Context
+const maxContextLength = 80
+
+// compiledRuleItem is a RuleItem with its patterns compiled
+type compiledRuleItem struct {
+	RuleItem
+	patterns        []*regexp.Regexp // Keyword itself (case-insensitive) if no pattern is configured
+	headingPatterns []*regexp.Regexp // Same patterns anchored at the start of a heading, used in sections mode
+	// Built-in patterns of the keyword per locale, added by localized
+	localePatterns        map[string][]*regexp.Regexp
+	localeHeadingPatterns map[string][]*regexp.Regexp
+}
+
+// compiledRuleSet is a RuleSet with its patterns compiled
+type compiledRuleSet struct {
+	RuleSet
+	items []compiledRuleItem
+}
+
+// DefaultRuleConfig returns the rule sets matching the built-in PR template.
+// The approval rule set is disabled: it is opt-in, by redefining it in rule_sets or a repository override.
+func DefaultRuleConfig() *RuleConfig {
+	return &RuleConfig{
+		RuleSets: []RuleSet{
+			keywordRuleSet(DefaultDescriptionRuleSet, RuleTargetDescription, DescriptionKeywords, descriptionKeywordPatterns, MinKeywordsDescription),
+			keywordRuleSet(DefaultReviewRuleSet, RuleTargetReview, ReviewCommentKeywords, reviewKeywordPatterns, MinKeywordsReviewComment),
+			{
+				Name:   DefaultChecklistRuleSet,
+				Target: RuleTargetDescription,
+				Match:  RuleMatchChecklist,
+				Items:  []RuleItem{{Keyword: "Self-Review", Patterns: descriptionKeywordPatterns["Self-Review"]}},
+			},
+			{
+				Name:         DefaultApprovalRuleSet,
+				Target:       RuleTargetApproval,
+				MinApprovals: 1,
+				Disabled:     true,
+			},
+		},
+	}
+}
+
+// keywordRuleSet builds an optional-items rule set from a keyword list
+func keywordRuleSet(name, target string, keywords []string, patterns map[string][]string, minMatches int) RuleSet {
+	ruleSet := RuleSet{
+		Name:       name,
+		Target:     target,
+		MinMatches: minMatches,
+	}
+	for _, keyword := range keywords {
+		ruleSet.Items = append(ruleSet.Items, RuleItem{
+			Keyword:  keyword,
+			Patterns: patterns[keyword],
+		})
+	}
+	return ruleSet
+}
+
+// compileRuleSet validates a rule set and compiles its patterns
+func compileRuleSet(ruleSet RuleSet) (*compiledRuleSet, error) {
+	if ruleSet.Name == "" {
+		return nil, fmt.Errorf("rule set thiếu name")
+	}
+	switch ruleSet.Target {
+	case RuleTargetDescription:
+	case RuleTargetReview:
+		switch ruleSet.Reviewers {
+		case "", ReviewerModeAny, ReviewerModeAll, ReviewerModeMajority:
+		default:
+			return nil, fmt.Errorf("rule set %s: reviewers không hợp lệ %q (any, all hoặc majority)", ruleSet.Name, ruleSet.Reviewers)
+		}
+	case RuleTargetApproval:
+		if ruleSet.MinApprovals < 0 {
+			return nil, fmt.Errorf("rule set %s: min_approvals không được âm", ruleSet.Name)
+		}
+	default:
+		return nil, fmt.Errorf("rule set %s: target không hợp lệ %q (description, review hoặc approval)", ruleSet.Name, ruleSet.Target)
+	}
+
+	switch ruleSet.Match {
+	case "":
+		ruleSet.Match = RuleMatchKeywords
+		if ruleSet.Target == RuleTargetDescription {
+			ruleSet.Match = RuleMatchSections
+		}
+	case RuleMatchSections, RuleMatchKeywords:
+	case RuleMatchChecklist:
+		if ruleSet.Target != RuleTargetDescription {
+			return nil, fmt.Errorf("rule set %s: match checklist chỉ dùng được với target description", ruleSet.Name)
+		}
+	default:
+		return nil, fmt.Errorf("rule set %s: match không hợp lệ %q (sections, keywords hoặc checklist)", ruleSet.Name, ruleSet.Match)
+	}
+	if ruleSet.MinCheckedPercent < 0 || ruleSet.MinCheckedPercent > 100 {
+		return nil, fmt.Errorf("rule set %s: min_checked_percent phải trong khoảng 0-100", ruleSet.Name)
+	}
+
+	compiled := &compiledRuleSet{RuleSet: ruleSet}
+	for _, item := range ruleSet.Items {
+		compiledItem := compiledRuleItem{
+			RuleItem:              item,
+			localePatterns:        make(map[string][]*regexp.Regexp),
+			localeHeadingPatterns: make(map[string][]*regexp.Regexp),
+		}
+		patterns := item.Patterns
+		if len(patterns) == 0 {
+			// Fallback to substring matching if no regex pattern is defined for the keyword.
+			patterns = []string{regexp.QuoteMeta(item.Keyword)}
+		}
+		var err error
+		if compiledItem.patterns, compiledItem.headingPatterns, err = compileItemPatterns(patterns); err != nil {
+			return nil, fmt.Errorf("rule set %s, keyword %s: regex không hợp lệ: %w", ruleSet.Name, item.Keyword, err)
+		}
+
+		// The built-in locale patterns always compile
+		for locale, keywords := range localeKeywordPatterns {
+			if len(keywords[item.Keyword]) > 0 {
+				compiledItem.localePatterns[locale], compiledItem.localeHeadingPatterns[locale], _ = compileItemPatterns(keywords[item.Keyword])
+			}
+		}
+		compiled.items = append(compiled.items, compiledItem)
+	}
+
+	return compiled, nil
+}
+
+// compileItemPatterns compiles the case-insensitive and heading regexes of item patterns, width-folded like the texts
+func compileItemPatterns(patterns []string) ([]*regexp.Regexp, []*regexp.Regexp, error) {
+	var compiled, headings []*regexp.Regexp
+	for _, pattern := range patterns {
+		pattern = FoldText(pattern)
+		re, err := regexp.Compile("(?i)" + pattern)
+		if err != nil {
+			return nil, nil, err
+		}
+		compiled = append(compiled, re)
+
+		headingRe, err := compileHeadingPattern(pattern)
+		if err != nil {
+			return nil, nil, err
+		}
+		headings = append(headings, headingRe)
+	}
+	return compiled, headings, nil
+}
+
+// localized returns the rule set with the built-in patterns of the locales added to its items
+func (rs *compiledRuleSet) localized(locales []string) *compiledRuleSet {
+	if len(locales) == 0 {
+		return rs
+	}
+
+	localized := &compiledRuleSet{RuleSet: rs.RuleSet, items: make([]compiledRuleItem, len(rs.items))}
+	for i, item := range rs.items {
+		patterns := append([]*regexp.Regexp{}, item.patterns...)
+		headingPatterns := append([]*regexp.Regexp{}, item.headingPatterns...)
+		for _, locale := range locales {
+			patterns = append(patterns, item.localePatterns[locale]...)
+			headingPatterns = append(headingPatterns, item.localeHeadingPatterns[locale]...)
+		}
+		item.patterns, item.headingPatterns = patterns, headingPatterns
+		localized.items[i] = item
+	}
+	return localized
+}
+
+// compileRuleSets compiles a list of rule sets
+func compileRuleSets(ruleSets []RuleSet) ([]*compiledRuleSet, error) {
+	compiled := make([]*compiledRuleSet, 0, len(ruleSets))
+	for _, ruleSet := range ruleSets {
+		c, err := compileRuleSet(ruleSet)
+		if err != nil {
+			return nil, err
+		}
+		compiled = append(compiled, c)
+	}
+	return compiled, nil
+}
+
+// find returns the first place the item is found in the text, trying patterns in order
+func (item *compiledRuleItem) find(text string) (KeywordMatch, bool) {
+	for _, pattern := range item.patterns {
+		if loc := pattern.FindStringIndex(text); loc != nil {
+			return KeywordMatch{
+				Keyword: item.Keyword,
+				Span:    text[loc[0]:loc[1]],
+				Start:   loc[0],
+				End:     loc[1],
+				Context: lineAround(text, loc[0], loc[1]),
+			}, true
+		}
+	}
+	return KeywordMatch{}, false
+}
+
+// lineAround returns the trimmed line containing text[start:end], shortened to maxContextLength
+func lineAround(text string, start, end int) string {
+	lineStart := strings.LastIndex(text[:start], "\n") + 1
+	lineEnd := len(text)
+	if i := strings.Index(text[end:], "\n"); i >= 0 {
+		lineEnd = end + i
+	}
+
+	line := []rune(strings.TrimSpace(text[lineStart:lineEnd]))
+	if len(line) > maxContextLength {
+		return string(line[:maxContextLength-3]) + "..."
+	}
+	return string(line)
+}
+
+// evaluate checks the rule set against the given text, normalized with FoldText
+func (rs *compiledRuleSet) evaluate(text string) *RuleResult {
+	text = FoldText(text)
+	result := &RuleResult{
+		Name:       rs.Name,
+		Target:     rs.Target,
+		MinMatches: rs.MinMatches,
+		Matched:    make([]KeywordMatch, 0),
+		Missing:    make([]string, 0),
+		Empty:      make([]string, 0),
+	}
+
+	var sections []*MarkdownSection
+	if rs.Match != RuleMatchKeywords {
+		sections = ParseMarkdownSections(text)
+	}
+	if rs.Match == RuleMatchChecklist {
+		result.Checklist = &ChecklistResult{Items: make([]ChecklistItem, 0)}
+	}
+
+	missingRequired := false
+	for i := range rs.items {
+		item := &rs.items[i]
+
+		var match KeywordMatch
+		found := false
+		switch rs.Match {
+		case RuleMatchSections:
+			if section, ok := item.findSection(sections); ok {
+				if HasRealContent(section.Content) {
+					match, found = sectionMatch(text, item.Keyword, section), true
+				} else {
+					result.Empty = append(result.Empty, item.Keyword)
+				}
+			}
+		case RuleMatchChecklist:
+			if section, ok := item.findSection(sections); ok {
+				if items := ParseChecklist(section); len(items) > 0 {
+					for _, checklistItem := range items {
+						if checklistItem.Checked {
+							result.Checklist.Checked++
+						} else {
+							result.Checklist.Unchecked++
+						}
+					}
+					result.Checklist.Items = append(result.Checklist.Items, items...)
+					match, found = sectionMatch(text, item.Keyword, section), true
+				} else {
+					result.Empty = append(result.Empty, item.Keyword)
+				}
+			}
+		default:
+			match, found = item.find(text)
+		}
+
+		if found {
+			result.MatchedCount++
+			result.Matched = append(result.Matched, match)
+		} else {
+			result.Missing = append(result.Missing, item.Keyword)
+			if item.Required {
+				missingRequired = true
+			}
+		}
+	}
+
+	result.Passed = !missingRequired && result.MatchedCount >= rs.MinMatches
+	if result.Checklist != nil {
+		result.Passed = result.Passed && rs.checklistPassed(result.Checklist)
+	}
+	return result
+}
+
+// sectionMatch explains a keyword found as a Markdown section heading
+func sectionMatch(text, keyword string, section *MarkdownSection) KeywordMatch {
+	return KeywordMatch{
+		Keyword: keyword,
+		Span:    section.Heading,
+		Start:   section.Start,
+		End:     section.End,
+		Context: lineAround(text, section.Start, section.End),
+	}
+}
+
+// keywordCheckResult converts rule results into a KeywordCheckResult; valid only if all of them passed
+func keywordCheckResult(ruleResults ...*RuleResult) *KeywordCheckResult {
+	result := &KeywordCheckResult{
+		Valid:   len(ruleResults) > 0,
+		Matched: make([]KeywordMatch, 0),
+		Missing: make([]string, 0),
+	}
+	for _, ruleResult := range ruleResults {
+		result.Valid = result.Valid && ruleResult.Passed
+		result.Matched = append(result.Matched, ruleResult.Matched...)
+		result.Missing = append(result.Missing, ruleResult.Missing...)
+	}
+	return result
+}
+
+// mergeRuleSets applies repository overrides on top of the default rule sets
+func mergeRuleSets(defaults, overrides []RuleSet) []RuleSet {
+	merged := make([]RuleSet, 0, len(defaults)+len(overrides))
+	overridden := make(map[string]bool)
+	for _, override := range overrides {
+		overridden[override.Name] = true
+	}
+
+	for _, ruleSet := range defaults {
+		if !overridden[ruleSet.Name] && !ruleSet.Disabled {
+			merged = append(merged, ruleSet)
+		}
+	}
+	for _, override := range overrides {
+		if !override.Disabled {
+			merged = append(merged, override)
+		}
+	}
+
+	return merged
+}
+
+// matchRepository checks if a repository full name matches an override key
+func matchRepository(pattern, repository string) bool {
+	if pattern == repository {
+		return true
+	}
+	matched, err := path.Match(pattern, repository)
+	return err == nil && matched
+}
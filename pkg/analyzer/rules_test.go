@@ -0,0 +1,312 @@
+package analyzer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bug-crawler/pkg/platform"
+)
+
+func TestNewPRRuleAnalyzerWithConfig_Defaults(t *testing.T) {
+	analyzer, err := NewPRRuleAnalyzerWithConfig(nil)
+	if err != nil {
+		t.Fatalf("NewPRRuleAnalyzerWithConfig failed: %v", err)
+	}
+
+	result := analyzer.AnalyzePRRule(&platform.PullRequestData{
+		Description: "## Description\nx\n## Changes Made\ny\n## Functionality\nz",
+	})
+
+	if len(result.RuleResults) != 3 {
+		t.Fatalf("Expected 3 default rule results (approval is opt-in), got %d", len(result.RuleResults))
+	}
+	if result.RuleResults[0].Name != DefaultDescriptionRuleSet || !result.RuleResults[0].Passed {
+		t.Errorf("Description rule = %+v, want passed %s", result.RuleResults[0], DefaultDescriptionRuleSet)
+	}
+	if result.RuleResults[1].Name != DefaultReviewRuleSet || result.RuleResults[1].Passed {
+		t.Errorf("Review rule = %+v, want failed %s", result.RuleResults[1], DefaultReviewRuleSet)
+	}
+	// The default checklist is only reported, it passes without a Self-Review section
+	if result.RuleResults[2].Name != DefaultChecklistRuleSet || !result.RuleResults[2].Passed {
+		t.Errorf("Checklist rule = %+v, want passed %s", result.RuleResults[2], DefaultChecklistRuleSet)
+	}
+	if !result.ApprovalValid {
+		t.Error("ApprovalValid = false, want true without an approval rule set")
+	}
+}
+
+func TestNewPRRuleAnalyzerWithConfig_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *RuleConfig
+	}{
+		{
+			name: "invalid regex",
+			config: &RuleConfig{RuleSets: []RuleSet{
+				{Name: "bad", Target: RuleTargetDescription, Items: []RuleItem{{Keyword: "X", Patterns: []string{"("}}}},
+			}},
+		},
+		{
+			name: "invalid target",
+			config: &RuleConfig{RuleSets: []RuleSet{
+				{Name: "bad", Target: "title", Items: []RuleItem{{Keyword: "X"}}},
+			}},
+		},
+		{
+			name: "missing name",
+			config: &RuleConfig{RuleSets: []RuleSet{
+				{Target: RuleTargetDescription, Items: []RuleItem{{Keyword: "X"}}},
+			}},
+		},
+		{
+			name: "invalid repository override",
+			config: &RuleConfig{Repositories: map[string][]RuleSet{
+				"org/api": {{Name: "bad", Target: RuleTargetReview, Items: []RuleItem{{Keyword: "X", Patterns: []string{"[a-"}}}}},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewPRRuleAnalyzerWithConfig(tt.config); err == nil {
+				t.Error("Expected error for invalid config")
+			}
+		})
+	}
+}
+
+func TestAnalyzePRRule_CustomRuleSets(t *testing.T) {
+	config := &RuleConfig{
+		RuleSets: []RuleSet{
+			{
+				Name:       "template",
+				Target:     RuleTargetDescription,
+				MinMatches: 2,
+				Items: []RuleItem{
+					{Keyword: "Summary", Patterns: []string{`summary|tl;dr`}, Required: true},
+					{Keyword: "Testing"},
+					{Keyword: "Rollback"},
+				},
+			},
+			{
+				Name:       "review",
+				Target:     RuleTargetReview,
+				MinMatches: 1,
+				Items:      []RuleItem{{Keyword: "LGTM"}},
+			},
+		},
+	}
+
+	analyzer, err := NewPRRuleAnalyzerWithConfig(config)
+	if err != nil {
+		t.Fatalf("NewPRRuleAnalyzerWithConfig failed: %v", err)
+	}
+
+	approved := []*platform.ReviewData{{ReviewerLogin: "r1", CommentBody: "lgtm"}}
+
+	tests := []struct {
+		name                 string
+		description          string
+		reviews              []*platform.ReviewData
+		wantDescriptionValid bool
+		wantReviewValid      bool
+	}{
+		{
+			name:                 "required and enough items",
+			description:          "## TL;DR\nsmall fix\n## Testing\nunit tests",
+			reviews:              approved,
+			wantDescriptionValid: true,
+			wantReviewValid:      true,
+		},
+		{
+			name:                 "enough items but required missing",
+			description:          "## Testing\ndone\n## Rollback\nrevert commit",
+			reviews:              approved,
+			wantDescriptionValid: false,
+			wantReviewValid:      true,
+		},
+		{
+			name:                 "required present but below minimum",
+			description:          "## Summary\nonly",
+			reviews:              approved,
+			wantDescriptionValid: false,
+			wantReviewValid:      true,
+		},
+		{
+			name:                 "no reviews",
+			description:          "## Summary\nx\n## Testing\ny",
+			reviews:              nil,
+			wantDescriptionValid: true,
+			wantReviewValid:      false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := analyzer.AnalyzePRRule(&platform.PullRequestData{Description: tt.description, Reviews: tt.reviews})
+
+			if result.PRDescriptionValid != tt.wantDescriptionValid {
+				t.Errorf("PRDescriptionValid = %v, want %v", result.PRDescriptionValid, tt.wantDescriptionValid)
+			}
+			if result.ReviewCommentValid != tt.wantReviewValid {
+				t.Errorf("ReviewCommentValid = %v, want %v", result.ReviewCommentValid, tt.wantReviewValid)
+			}
+			if result.PRCompliant != (tt.wantDescriptionValid && tt.wantReviewValid) {
+				t.Errorf("PRCompliant = %v", result.PRCompliant)
+			}
+		})
+	}
+}
+
+func TestAnalyzePRRule_RepositoryOverrides(t *testing.T) {
+	config := &RuleConfig{
+		Repositories: map[string][]RuleSet{
+			// Legacy repositories don't use the PR template
+			"org/legacy-*": {{Name: DefaultDescriptionRuleSet, Disabled: true}},
+			// The API repository requires a Security section
+			"org/api": {{
+				Name:       "security",
+				Target:     RuleTargetDescription,
+				MinMatches: 1,
+				Items:      []RuleItem{{Keyword: "Security", Required: true}},
+			}},
+		},
+	}
+
+	analyzer, err := NewPRRuleAnalyzerWithConfig(config)
+	if err != nil {
+		t.Fatalf("NewPRRuleAnalyzerWithConfig failed: %v", err)
+	}
+
+	description := "## Description\nx\n## Changes Made\ny\n## Functionality\nz"
+
+	tests := []struct {
+		repository    string
+		wantRuleNames []string
+		wantValid     bool
+	}{
+		{repository: "org/web", wantRuleNames: []string{DefaultDescriptionRuleSet, DefaultReviewRuleSet, DefaultChecklistRuleSet}, wantValid: true},
+		{repository: "org/legacy-app", wantRuleNames: []string{DefaultReviewRuleSet, DefaultChecklistRuleSet}, wantValid: true},
+		{repository: "org/api", wantRuleNames: []string{DefaultDescriptionRuleSet, DefaultReviewRuleSet, DefaultChecklistRuleSet, "security"}, wantValid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.repository, func(t *testing.T) {
+			result := analyzer.AnalyzePRRule(&platform.PullRequestData{Repository: tt.repository, Description: description})
+
+			var names []string
+			for _, ruleResult := range result.RuleResults {
+				names = append(names, ruleResult.Name)
+			}
+			if len(names) != len(tt.wantRuleNames) {
+				t.Fatalf("Rule names = %v, want %v", names, tt.wantRuleNames)
+			}
+			for i := range names {
+				if names[i] != tt.wantRuleNames[i] {
+					t.Errorf("Rule names = %v, want %v", names, tt.wantRuleNames)
+				}
+			}
+
+			if result.PRDescriptionValid != tt.wantValid {
+				t.Errorf("PRDescriptionValid = %v, want %v", result.PRDescriptionValid, tt.wantValid)
+			}
+		})
+	}
+}
+
+func TestCheckKeywordsInText_Explain(t *testing.T) {
+	analyzer := NewPRRuleAnalyzer()
+
+	text := "## Description\nAdd login.\nUpload to s3 bucket\n## CM1: handler\nUpdated handler\n## Dependencies\n"
+	result := analyzer.CheckKeywordsInText(text, []string{"Description", "Changes Made", "Security", "Dependencies"})
+
+	if result.Valid {
+		t.Error("Expected invalid result with 2 of 4 sections")
+	}
+
+	matched := make(map[string]KeywordMatch)
+	for _, match := range result.Matched {
+		matched[match.Keyword] = match
+	}
+
+	if m := matched["Description"]; m.Span != "Description" || text[m.Start:m.End] != "## Description" || m.Context != "## Description" {
+		t.Errorf("Description match = %+v", m)
+	}
+	if m := matched["Changes Made"]; m.Span != "CM1: handler" || m.Context != "## CM1: handler" {
+		t.Errorf("Changes Made match = %+v", m)
+	}
+	// "s3" in a sentence is not a Security section, and the Dependencies section is empty
+	if !equalStrings(result.Missing, []string{"Security", "Dependencies"}) {
+		t.Errorf("Missing = %v, want [Security Dependencies]", result.Missing)
+	}
+}
+
+func TestCheckReviewComments_Explain(t *testing.T) {
+	analyzer := NewPRRuleAnalyzer()
+
+	result := analyzer.CheckReviewComments([]*platform.ReviewData{
+		{ReviewerLogin: "r1", CommentBody: "Functionality OK"},
+		{ReviewerLogin: "r1", CommentBody: "Security OK, error handling OK"},
+	})
+
+	if !result.Valid {
+		t.Error("Expected valid result")
+	}
+	if len(result.Matched) != 3 {
+		t.Errorf("Matched = %+v, want 3 keywords", result.Matched)
+	}
+	if len(result.Missing) != 2 || result.Missing[0] != "Code Style" || result.Missing[1] != "Code Readability" {
+		t.Errorf("Missing = %v, want [Code Style Code Readability]", result.Missing)
+	}
+}
+
+func TestLineAround(t *testing.T) {
+	text := "first line\n  second security line  \nthird"
+	start := strings.Index(text, "security")
+
+	if got := lineAround(text, start, start+len("security")); got != "second security line" {
+		t.Errorf("lineAround = %q, want %q", got, "second security line")
+	}
+
+	long := "security " + strings.Repeat("é", 100)
+	if got := lineAround(long, 0, len("security")); len([]rune(got)) != maxContextLength || !strings.HasSuffix(got, "...") {
+		t.Errorf("lineAround did not truncate: %q", got)
+	}
+}
+
+func TestAnalyzePRRule_ApprovalOptIn(t *testing.T) {
+	analyzer, err := NewPRRuleAnalyzerWithConfig(&RuleConfig{
+		Repositories: map[string][]RuleSet{
+			"org/secure": {{Name: DefaultApprovalRuleSet, Target: RuleTargetApproval, MinApprovals: 1}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewPRRuleAnalyzerWithConfig failed: %v", err)
+	}
+
+	for repository, wantValid := range map[string]bool{"org/web": true, "org/secure": false} {
+		result := analyzer.AnalyzePRRule(&platform.PullRequestData{Repository: repository, Author: "alice"})
+		if result.ApprovalValid != wantValid {
+			t.Errorf("%s: ApprovalValid = %v, want %v", repository, result.ApprovalValid, wantValid)
+		}
+	}
+}
+
+func TestAnalyzePRRule_ApprovalUnavailable(t *testing.T) {
+	analyzer, err := NewPRRuleAnalyzerWithConfig(&RuleConfig{
+		RuleSets: []RuleSet{{Name: DefaultApprovalRuleSet, Target: RuleTargetApproval, MinApprovals: 1}},
+	})
+	if err != nil {
+		t.Fatalf("NewPRRuleAnalyzerWithConfig failed: %v", err)
+	}
+	analyzer.SetApprovalsAvailable(false)
+
+	result := analyzer.AnalyzePRRule(&platform.PullRequestData{Repository: "org/web", Author: "alice"})
+	if !result.ApprovalUnknown || !result.ApprovalValid || !result.PRCompliant {
+		t.Errorf("unavailable approval must neither pass nor fail: unknown=%v valid=%v compliant=%v",
+			result.ApprovalUnknown, result.ApprovalValid, result.PRCompliant)
+	}
+	if len(result.RuleResults) != 1 || result.RuleResults[0].Approval == nil || !result.RuleResults[0].Approval.Unavailable {
+		t.Errorf("approval rule result should be marked unavailable: %+v", result.RuleResults)
+	}
+}
@@ -0,0 +1,206 @@
+package analyzer
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Sources of the severity and category of a bug-related PR
+const (
+	ClassifiedByLabel     = "label"
+	ClassifiedByBugReview = "bug_review"
+	ClassifiedByKeyword   = "keyword"
+)
+
+var (
+	// DefaultSeverityLabels maps each severity to label values, e.g. "critical", "severity:high", "priority/low"
+	DefaultSeverityLabels = map[string][]string{
+		SeverityCritical: {"critical", "blocker", "urgent", "highest", "p0"},
+		SeverityMajor:    {"major", "high", "p1"},
+		SeverityMinor:    {"minor", "medium", "low", "lowest", "trivial", "p2", "p3"},
+	}
+
+	// DefaultSeverityKeywords maps each severity to case-insensitive regexes on the title and description
+	DefaultSeverityKeywords = map[string][]string{
+		SeverityCritical: {`\bcrash(es|ed)?\b`, `\boutage\b`, `\bdata loss\b`, `\bproduction down\b`},
+	}
+
+	// DefaultCategoryLabels maps each category to label values, e.g. "regression", "area/ui", "type: security"
+	DefaultCategoryLabels = map[string][]string{
+		"regression":  {"regression"},
+		"ui":          {"ui", "ux", "frontend", "css", "design"},
+		"data":        {"data", "database", "db", "migration"},
+		"security":    {"security", "vulnerability", "cve"},
+		"performance": {"performance", "perf"},
+	}
+
+	// DefaultCategoryKeywords maps each category to case-insensitive regexes on the title and description
+	DefaultCategoryKeywords = map[string][]string{
+		"regression":  {`\bregression\b`, `デグレ`, `リグレッション`},
+		"security":    {`\bsecurity\b`, `\bxss\b`, `\bcsrf\b`, `\bsql injection\b`, `\bvulnerabilit(y|ies)\b`},
+		"performance": {`\bperformance\b`, `\bslow\b`, `\bmemory leak\b`},
+	}
+
+	// classificationLabelPrefixRegex strips the prefix of labels like "severity:high", "priority/low", "area-ui"
+	classificationLabelPrefixRegex = regexp.MustCompile(`(?i)^(?:severity|sev|priority|prio|type|category|area|kind)\s*[:/=_-]\s*`)
+)
+
+// labelClassifier maps normalized label values to a class
+type labelClassifier map[string]string
+
+// keywordClassifier is a class with the regexes matching it on the title and description
+type keywordClassifier struct {
+	class    string
+	patterns []*regexp.Regexp
+}
+
+// newLabelClassifier builds a lookup of label values. Classes are added in the given order, the first one wins.
+func newLabelClassifier(labels map[string][]string, classes []string) labelClassifier {
+	classifier := make(labelClassifier)
+	for _, class := range classes {
+		for _, value := range labels[class] {
+			value = strings.ToLower(strings.TrimSpace(value))
+			if _, exists := classifier[value]; !exists {
+				classifier[value] = class
+			}
+		}
+	}
+	return classifier
+}
+
+// match returns the class of the first label having a mapped value
+func (lc labelClassifier) match(labels []string) (string, bool) {
+	for _, label := range labels {
+		value := strings.ToLower(strings.TrimSpace(classificationLabelPrefixRegex.ReplaceAllString(label, "")))
+		if class, exists := lc[value]; exists {
+			return class, true
+		}
+	}
+	return "", false
+}
+
+// newKeywordClassifiers compiles the keyword regexes of each class, in the given order
+func newKeywordClassifiers(keywords map[string][]string, classes []string) ([]keywordClassifier, error) {
+	var classifiers []keywordClassifier
+	for _, class := range classes {
+		if len(keywords[class]) == 0 {
+			continue
+		}
+		patterns, err := compileDetectionPatterns(keywords[class])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", class, err)
+		}
+		classifiers = append(classifiers, keywordClassifier{class: class, patterns: patterns})
+	}
+	return classifiers, nil
+}
+
+// matchKeywords returns the first class with a regex matching one of the texts
+func matchKeywords(classifiers []keywordClassifier, texts ...string) (string, bool) {
+	for _, classifier := range classifiers {
+		for _, re := range classifier.patterns {
+			for _, text := range texts {
+				if re.MatchString(text) {
+					return classifier.class, true
+				}
+			}
+		}
+	}
+	return "", false
+}
+
+// sortedClasses returns the keys of a class mapping in alphabetical order
+func sortedClasses(mapping map[string][]string) []string {
+	classes := make([]string, 0, len(mapping))
+	for class := range mapping {
+		classes = append(classes, class)
+	}
+	sort.Strings(classes)
+	return classes
+}
+
+// lowerClasses lower-cases the category names of a mapping, like bug_review breakdown keys
+func lowerClasses(mapping map[string][]string) map[string][]string {
+	lowered := make(map[string][]string, len(mapping))
+	for class, values := range mapping {
+		key := strings.ToLower(strings.TrimSpace(class))
+		lowered[key] = append(lowered[key], values...)
+	}
+	return lowered
+}
+
+// validateSeverities rejects severity mappings with keys other than Severities
+func validateSeverities(mapping map[string][]string) error {
+	for severity := range mapping {
+		if !isSeverity(severity) {
+			return fmt.Errorf("severity không hợp lệ %q (chỉ chấp nhận %s)", severity, strings.Join(Severities, ", "))
+		}
+	}
+	return nil
+}
+
+// classify sets the severity and category of a bug-related PR.
+// Labels win over bug_review annotations, which win over title/description keywords.
+func (ba *BugAnalyzer) classify(result *BugResult) {
+	pr := result.PR
+
+	tags := result.BugReviewTags
+	if tags == nil {
+		tags = bugReviewTags(pr)
+	}
+	severityCounts, categoryCounts := sumBreakdowns(tags)
+
+	if severity, found := ba.severityLabels.match(pr.Labels); found {
+		result.Severity, result.SeveritySource = severity, ClassifiedByLabel
+	} else if severity, found := mostSevere(severityCounts); found {
+		result.Severity, result.SeveritySource = severity, ClassifiedByBugReview
+	} else if severity, found := matchKeywords(ba.severityKeywords, pr.Title, pr.Description); found {
+		result.Severity, result.SeveritySource = severity, ClassifiedByKeyword
+	}
+
+	if category, found := ba.categoryLabels.match(pr.Labels); found {
+		result.Category, result.CategorySource = category, ClassifiedByLabel
+	} else if category, found := mostFrequent(categoryCounts); found {
+		result.Category, result.CategorySource = category, ClassifiedByBugReview
+	} else if category, found := matchKeywords(ba.categoryKeywords, pr.Title, pr.Description); found {
+		result.Category, result.CategorySource = category, ClassifiedByKeyword
+	}
+}
+
+// sumBreakdowns sums the severity and category breakdowns of bug_review tags
+func sumBreakdowns(tags []BugReviewTag) (map[string]int, map[string]int) {
+	bySeverity := make(map[string]int)
+	byCategory := make(map[string]int)
+	for _, tag := range tags {
+		for severity, n := range tag.BySeverity {
+			bySeverity[severity] += n
+		}
+		for category, n := range tag.ByCategory {
+			byCategory[category] += n
+		}
+	}
+	return bySeverity, byCategory
+}
+
+// mostSevere returns the most severe severity with at least one bug
+func mostSevere(bySeverity map[string]int) (string, bool) {
+	for _, severity := range Severities {
+		if bySeverity[severity] > 0 {
+			return severity, true
+		}
+	}
+	return "", false
+}
+
+// mostFrequent returns the category with the most bugs, alphabetically first on ties
+func mostFrequent(byCategory map[string]int) (string, bool) {
+	best := ""
+	for category, n := range byCategory {
+		if n > 0 && (best == "" || n > byCategory[best] || (n == byCategory[best] && category < best)) {
+			best = category
+		}
+	}
+	return best, best != ""
+}
@@ -0,0 +1,124 @@
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/bug-crawler/pkg/platform"
+)
+
+func TestAnalyzePR_Classification(t *testing.T) {
+	tests := []struct {
+		name               string
+		pr                 *platform.PullRequestData
+		wantSeverity       string
+		wantSeveritySource string
+		wantCategory       string
+		wantCategorySource string
+	}{
+		{
+			name:               "labels",
+			pr:                 &platform.PullRequestData{Labels: []string{"bug", "severity:high", "area/UI"}},
+			wantSeverity:       SeverityMajor,
+			wantSeveritySource: ClassifiedByLabel,
+			wantCategory:       "ui",
+			wantCategorySource: ClassifiedByLabel,
+		},
+		{
+			name:               "critical label",
+			pr:                 &platform.PullRequestData{Labels: []string{"critical"}},
+			wantSeverity:       SeverityCritical,
+			wantSeveritySource: ClassifiedByLabel,
+		},
+		{
+			name: "bug_review annotations",
+			pr: &platform.PullRequestData{
+				Labels:      []string{"bug"},
+				Description: "bug_review: 3 (minor: 2, major: 1, data: 2, ui: 1)",
+			},
+			wantSeverity:       SeverityMajor,
+			wantSeveritySource: ClassifiedByBugReview,
+			wantCategory:       "data",
+			wantCategorySource: ClassifiedByBugReview,
+		},
+		{
+			name: "keywords",
+			pr: &platform.PullRequestData{
+				Title:  "Fix crash after login regression",
+				Labels: []string{"bug"},
+			},
+			wantSeverity:       SeverityCritical,
+			wantSeveritySource: ClassifiedByKeyword,
+			wantCategory:       "regression",
+			wantCategorySource: ClassifiedByKeyword,
+		},
+		{
+			name: "label wins over keywords",
+			pr: &platform.PullRequestData{
+				Title:  "Fix crash in settings",
+				Labels: []string{"bug", "priority: low"},
+			},
+			wantSeverity:       SeverityMinor,
+			wantSeveritySource: ClassifiedByLabel,
+		},
+		{
+			name: "unclassified",
+			pr:   &platform.PullRequestData{Title: "Fix typo", Labels: []string{"bug"}},
+		},
+	}
+
+	analyzer := NewBugAnalyzer()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := analyzer.AnalyzePR(tt.pr, "bug", "github")
+			if !result.IsBugRelated {
+				t.Fatal("expected bug-related PR")
+			}
+			if result.Severity != tt.wantSeverity || result.SeveritySource != tt.wantSeveritySource {
+				t.Errorf("severity = %q (%s), want %q (%s)", result.Severity, result.SeveritySource, tt.wantSeverity, tt.wantSeveritySource)
+			}
+			if result.Category != tt.wantCategory || result.CategorySource != tt.wantCategorySource {
+				t.Errorf("category = %q (%s), want %q (%s)", result.Category, result.CategorySource, tt.wantCategory, tt.wantCategorySource)
+			}
+		})
+	}
+
+	t.Run("not bug-related", func(t *testing.T) {
+		result := analyzer.AnalyzePR(&platform.PullRequestData{Labels: []string{"severity:high"}}, "bug", "github")
+		if result.IsBugRelated || result.Severity != "" {
+			t.Errorf("AnalyzePR() = related %v, severity %q, want unclassified", result.IsBugRelated, result.Severity)
+		}
+	})
+}
+
+func TestNewBugAnalyzerWithConfig_Classification(t *testing.T) {
+	ba, err := NewBugAnalyzerWithConfig(&BugDetectionConfig{
+		SeverityLabels:   map[string][]string{SeverityCritical: {"S1"}},
+		CategoryKeywords: map[string][]string{"Payment": {`\bcheckout\b`}},
+	})
+	if err != nil {
+		t.Fatalf("NewBugAnalyzerWithConfig() error = %v", err)
+	}
+
+	result := ba.AnalyzePR(&platform.PullRequestData{Title: "Fix checkout total", Labels: []string{"bug", "sev-s1", "ui"}}, "bug", "github")
+	if result.Severity != SeverityCritical {
+		t.Errorf("severity = %q, want critical", result.Severity)
+	}
+	if result.Category != "ui" {
+		t.Errorf("category = %q, want ui from the default labels", result.Category)
+	}
+
+	result = ba.AnalyzePR(&platform.PullRequestData{Title: "Fix checkout total", Labels: []string{"bug", "high"}}, "bug", "github")
+	if result.Severity != "" {
+		t.Errorf("severity = %q, want none once the severity labels are replaced", result.Severity)
+	}
+	if result.Category != "payment" {
+		t.Errorf("category = %q, want payment", result.Category)
+	}
+
+	if _, err := NewBugAnalyzerWithConfig(&BugDetectionConfig{SeverityLabels: map[string][]string{"blocker": {"b"}}}); err == nil {
+		t.Error("expected error for unknown severity")
+	}
+	if _, err := NewBugAnalyzerWithConfig(&BugDetectionConfig{CategoryKeywords: map[string][]string{"ui": {"("}}}); err == nil {
+		t.Error("expected error for invalid keyword regex")
+	}
+}
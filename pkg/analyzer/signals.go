@@ -0,0 +1,155 @@
+package analyzer
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/bug-crawler/pkg/platform"
+)
+
+// Bug signals evaluated by the combined detection mode. Their names match the DetectionType of the single-signal modes.
+const (
+	SignalBugReview   = "bug_review"
+	SignalDescription = "description_regex"
+	SignalLabel       = "label"
+	SignalBranch      = "branch"
+	SignalTitle       = "title"
+	SignalLinkedIssue = "linked_issue"
+)
+
+// DefaultSignalWeights are the confidence each signal gives on its own that a PR is a bug fix
+var DefaultSignalWeights = map[string]float64{
+	SignalBugReview:   0.9,
+	SignalLinkedIssue: 0.9,
+	SignalDescription: 0.8,
+	SignalLabel:       0.7,
+	SignalTitle:       0.7,
+	SignalBranch:      0.5,
+}
+
+// DefaultConfidenceThreshold is the minimum confidence of a bug-related PR in combined mode
+const DefaultConfidenceThreshold = 0.5
+
+// typeBugRegex matches the "type: bug" marker used in Bitbucket and Backlog descriptions
+var typeBugRegex = regexp.MustCompile(`(?i)type:\s*bug`)
+
+// BugSignal is a bug signal that fired for a PR
+type BugSignal struct {
+	Type   string  // One of the Signal* constants
+	Value  string  // What matched: label, branch, title prefix...
+	Weight float64 // Confidence given by the signal
+}
+
+// matchTypeBug checks the "type: bug" marker and its localized forms ("種別: バグ"), only used on Bitbucket and Backlog
+func (ba *BugAnalyzer) matchTypeBug(pr *platform.PullRequestData, platformType string) bool {
+	if platformType != "bitbucket" && platformType != "backlog" {
+		return false
+	}
+	description := FoldText(pr.Description)
+	if typeBugRegex.MatchString(description) {
+		return true
+	}
+	for _, locale := range ba.locales.For(pr.Repository) {
+		if localeTypeBugRegexes[locale].MatchString(description) {
+			return true
+		}
+	}
+	return false
+}
+
+// SetLocales selects the locales of the "type: bug" marker for each repository
+func (ba *BugAnalyzer) SetLocales(locales *LocaleSelector) {
+	ba.locales = locales
+}
+
+// matchLabel returns the first bug label of a PR according to the label taxonomy of its repository
+func (ba *BugAnalyzer) matchLabel(pr *platform.PullRequestData) (string, bool) {
+	for _, label := range pr.Labels {
+		if ba.labels.isBug(pr.Repository, label) {
+			return label, true
+		}
+	}
+	return "", false
+}
+
+// collectSignals evaluates every bug signal of a PR. The bug_review tags are returned separately.
+func (ba *BugAnalyzer) collectSignals(pr *platform.PullRequestData, platformType string, title *ConventionalTitle) ([]BugSignal, []BugReviewTag) {
+	var signals []BugSignal
+	add := func(signalType, value string) {
+		signals = append(signals, BugSignal{Type: signalType, Value: value, Weight: ba.signalWeights[signalType]})
+	}
+
+	tags := bugReviewTags(pr)
+	bugCount := 0
+	for _, tag := range tags {
+		bugCount += tag.Count
+	}
+	if bugCount > 0 {
+		add(SignalBugReview, fmt.Sprintf("%d bugs", bugCount))
+	}
+	if ba.matchTypeBug(pr, platformType) {
+		add(SignalDescription, "type: bug")
+	}
+	if label, found := ba.matchLabel(pr); found {
+		add(SignalLabel, label)
+	}
+	if branch, found := ba.matchBranch(pr); found {
+		add(SignalBranch, branch)
+	}
+	if prefix, found := ba.matchTitle(title); found {
+		add(SignalTitle, prefix)
+	}
+	if issue, found := ba.matchLinkedIssue(pr); found {
+		add(SignalLinkedIssue, issue)
+	}
+
+	return signals, tags
+}
+
+// Confidence combines the weights of independent signals: 1 - Π(1 - weight).
+// Each signal raises the confidence without exceeding 1, so a PR is never counted twice.
+func Confidence(signals []BugSignal) float64 {
+	remaining := 1.0
+	for _, signal := range signals {
+		remaining *= 1 - signal.Weight
+	}
+	return 1 - remaining
+}
+
+// signalTypes returns the fired signal types joined with "+", e.g. "label+branch"
+func signalTypes(signals []BugSignal) string {
+	types := make([]string, len(signals))
+	for i, signal := range signals {
+		types[i] = signal.Type
+	}
+	return strings.Join(types, "+")
+}
+
+// validateSignalWeights merges custom signal weights into the defaults
+func validateSignalWeights(custom map[string]float64) (map[string]float64, error) {
+	weights := make(map[string]float64, len(DefaultSignalWeights))
+	for signal, weight := range DefaultSignalWeights {
+		weights[signal] = weight
+	}
+
+	signals := make([]string, 0, len(custom))
+	for signal := range custom {
+		signals = append(signals, signal)
+	}
+	sort.Strings(signals)
+
+	for _, signal := range signals {
+		weight := custom[signal]
+		if _, known := DefaultSignalWeights[signal]; !known {
+			return nil, fmt.Errorf("signal_weights: signal %q không hợp lệ", signal)
+		}
+		if weight < 0 || weight > 1 {
+			return nil, fmt.Errorf("signal_weights: %s phải trong khoảng 0-1, nhận %g", signal, weight)
+		}
+		weights[signal] = weight
+	}
+
+	return weights, nil
+}
@@ -0,0 +1,107 @@
+package analyzer
+
+import (
+	"math"
+	"testing"
+
+	"github.com/bug-crawler/pkg/platform"
+)
+
+func TestAnalyzePR_Combined(t *testing.T) {
+	tests := []struct {
+		name           string
+		config         *BugDetectionConfig
+		platformType   string
+		pr             *platform.PullRequestData
+		wantBug        bool
+		wantKeyword    string
+		wantConfidence float64
+		wantBugCount   int
+	}{
+		{
+			name:           "label and bug_review counted once",
+			platformType:   "github",
+			pr:             &platform.PullRequestData{Labels: []string{"bug"}, Description: "bug_review: 3"},
+			wantBug:        true,
+			wantKeyword:    "bug_review+label",
+			wantConfidence: 0.97,
+			wantBugCount:   3,
+		},
+		{
+			name:           "all signals",
+			platformType:   "bitbucket",
+			pr:             &platform.PullRequestData{Title: "fix(api): x", SourceBranch: "fix/x", Description: "type: bug"},
+			wantBug:        true,
+			wantKeyword:    "description_regex+branch+title",
+			wantConfidence: 0.97,
+		},
+		{
+			name:           "branch alone reaches default threshold",
+			platformType:   "github",
+			pr:             &platform.PullRequestData{SourceBranch: "hotfix/x"},
+			wantBug:        true,
+			wantKeyword:    "branch",
+			wantConfidence: 0.5,
+		},
+		{
+			name:           "branch alone below custom threshold",
+			config:         &BugDetectionConfig{Threshold: 0.6},
+			platformType:   "github",
+			pr:             &platform.PullRequestData{SourceBranch: "hotfix/x"},
+			wantBug:        false,
+			wantConfidence: 0.5,
+		},
+		{
+			name:           "custom weight",
+			config:         &BugDetectionConfig{Threshold: 0.6, SignalWeights: map[string]float64{SignalBranch: 0.8}},
+			platformType:   "github",
+			pr:             &platform.PullRequestData{SourceBranch: "hotfix/x"},
+			wantBug:        true,
+			wantKeyword:    "branch",
+			wantConfidence: 0.8,
+		},
+		{
+			name:         "no signal",
+			platformType: "github",
+			pr:           &platform.PullRequestData{Title: "feat: x", SourceBranch: "feature/x"},
+			wantBug:      false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			analyzer, err := NewBugAnalyzerWithConfig(tt.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			result := analyzer.AnalyzePR(tt.pr, "combined", tt.platformType)
+			if result.IsBugRelated != tt.wantBug {
+				t.Errorf("IsBugRelated = %v, want %v", result.IsBugRelated, tt.wantBug)
+			}
+			if result.MatchedKeyword != tt.wantKeyword {
+				t.Errorf("MatchedKeyword = %q, want %q", result.MatchedKeyword, tt.wantKeyword)
+			}
+			if math.Abs(result.Confidence-tt.wantConfidence) > 1e-9 {
+				t.Errorf("Confidence = %v, want %v", result.Confidence, tt.wantConfidence)
+			}
+			if result.BugCount != tt.wantBugCount {
+				t.Errorf("BugCount = %d, want %d", result.BugCount, tt.wantBugCount)
+			}
+		})
+	}
+}
+
+func TestNewBugAnalyzerWithConfig_InvalidSignals(t *testing.T) {
+	configs := []*BugDetectionConfig{
+		{SignalWeights: map[string]float64{"unknown": 0.5}},
+		{SignalWeights: map[string]float64{SignalLabel: 1.5}},
+		{Threshold: 2},
+	}
+
+	for _, config := range configs {
+		if _, err := NewBugAnalyzerWithConfig(config); err == nil {
+			t.Errorf("Expected error for config %+v", config)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package analyzer
+
+import "github.com/bug-crawler/pkg/platform"
+
+// PR size buckets, by number of changed lines (additions + deletions)
+const (
+	SizeXS      = "XS"
+	SizeS       = "S"
+	SizeM       = "M"
+	SizeL       = "L"
+	SizeXL      = "XL"
+	SizeUnknown = "unknown" // Diff statistics not fetched or not available on the platform
+)
+
+// SizeThreshold is the largest number of changed lines of a size bucket
+type SizeThreshold struct {
+	Size     string
+	MaxLines int
+}
+
+// SizeThresholds are the size buckets from smallest to largest; larger PRs are XL
+var SizeThresholds = []SizeThreshold{
+	{Size: SizeXS, MaxLines: 9},
+	{Size: SizeS, MaxLines: 49},
+	{Size: SizeM, MaxLines: 249},
+	{Size: SizeL, MaxLines: 999},
+}
+
+// SizeBuckets lists all size buckets in display order
+var SizeBuckets = []string{SizeXS, SizeS, SizeM, SizeL, SizeXL, SizeUnknown}
+
+// ChangedLines returns the number of lines added and deleted by a PR
+func ChangedLines(pr *platform.PullRequestData) int {
+	return pr.Additions + pr.Deletions
+}
+
+// SizeBucket returns the size bucket of a PR from its diff statistics
+func SizeBucket(pr *platform.PullRequestData) string {
+	if !pr.HasStats() {
+		return SizeUnknown
+	}
+
+	lines := ChangedLines(pr)
+	for _, threshold := range SizeThresholds {
+		if lines <= threshold.MaxLines {
+			return threshold.Size
+		}
+	}
+	return SizeXL
+}
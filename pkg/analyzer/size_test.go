@@ -0,0 +1,31 @@
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/bug-crawler/pkg/platform"
+)
+
+func TestSizeBucket(t *testing.T) {
+	tests := []struct {
+		name string
+		pr   *platform.PullRequestData
+		want string
+	}{
+		{"no stats", &platform.PullRequestData{}, SizeUnknown},
+		{"only commits fetched", &platform.PullRequestData{Commits: 1}, SizeXS},
+		{"xs upper bound", &platform.PullRequestData{Additions: 5, Deletions: 4, ChangedFiles: 1}, SizeXS},
+		{"s", &platform.PullRequestData{Additions: 10, ChangedFiles: 1}, SizeS},
+		{"m", &platform.PullRequestData{Additions: 200, Deletions: 49, ChangedFiles: 4}, SizeM},
+		{"l", &platform.PullRequestData{Additions: 250, ChangedFiles: 8}, SizeL},
+		{"xl", &platform.PullRequestData{Additions: 800, Deletions: 200, ChangedFiles: 30}, SizeXL},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SizeBucket(tt.pr); got != tt.want {
+				t.Errorf("SizeBucket() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -27,6 +27,11 @@ func (tm *TokenManager) GetTokenForPlatform(platform string) (string, error) {
 			return envToken, nil
 		}
 	}
+	if platform == "jira" {
+		if envToken := os.Getenv("JIRA_API_TOKEN"); envToken != "" {
+			return envToken, nil
+		}
+	}
 
 	// Check platform-specific config file
 	tokenFile := filepath.Join(tm.configDir, platform+"_token")
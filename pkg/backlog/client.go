@@ -7,6 +7,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
 	"sync"
 	"time"
 
@@ -15,10 +16,18 @@ import (
 
 // Client wraps Backlog API client
 type Client struct {
-	httpClient *http.Client
-	spaceID    string
-	apiKey     string
-	baseURL    string
+	httpClient       *http.Client
+	spaceID          string
+	apiKey           string
+	baseURL          string
+	starsAsApprovals bool
+}
+
+// Config contains the optional Backlog settings of the config file
+type Config struct {
+	// StarsAsApprovals counts a star given on a PR comment as an approval by the star's presenter.
+	// Backlog has no approve action, so this is only meaningful for teams using stars by convention.
+	StarsAsApprovals bool `json:"stars_as_approvals,omitempty"`
 }
 
 // NewClient initializes Backlog client
@@ -39,6 +48,16 @@ func NewClient(spaceID, apiKey, domain string) (*Client, error) {
 	}, nil
 }
 
+// SetStarsAsApprovals enables counting stars on PR comments as approvals (see Config.StarsAsApprovals)
+func (c *Client) SetStarsAsApprovals(enabled bool) {
+	c.starsAsApprovals = enabled
+}
+
+// ReportsApprovals implements platform.ApprovalSource: Backlog only reports approvals when stars count as approvals
+func (c *Client) ReportsApprovals() bool {
+	return c.starsAsApprovals
+}
+
 // doRequest performs an HTTP request with API key authentication
 func (c *Client) doRequest(ctx context.Context, method, path string, params url.Values) ([]byte, error) {
 	if params == nil {
@@ -209,6 +228,18 @@ func (c *Client) GetPullRequests(ctx context.Context, projectKey, repoName strin
 		Created *time.Time `json:"created"`
 		Updated *time.Time `json:"updated"`
 		Merged  *time.Time `json:"merged"`
+		Branch  string     `json:"branch"` // Source branch
+		Base    string     `json:"base"`   // Target branch
+		Issue   *struct {
+			IssueKey  string `json:"issueKey"`
+			Summary   string `json:"summary"`
+			IssueType struct {
+				Name string `json:"name"`
+			} `json:"issueType"`
+			Status struct {
+				Name string `json:"name"`
+			} `json:"status"`
+		} `json:"issue"` // Issue linked to the PR, if any
 	}
 
 	if err := json.Unmarshal(body, &pullRequests); err != nil {
@@ -233,15 +264,28 @@ func (c *Client) GetPullRequests(ctx context.Context, projectKey, repoName strin
 		}
 
 		prData := &platform.PullRequestData{
-			Number:      pr.Number,
-			Title:       pr.Summary,
-			Description: pr.Description,
-			Author:      pr.CreatedUser.Name,
-			CreatedAt:   createdAt,
-			MergedAt:    pr.Merged,
-			Labels:      []string{}, // Backlog doesn't have labels on PRs
-			HTMLURL:     fmt.Sprintf("https://%s.backlog.com/git/%s/%s/pullRequests/%d", c.spaceID, projectKey, repoName, pr.Number),
-			Status:      status,
+			Repository:   projectKey + "/" + repoName,
+			Number:       pr.Number,
+			Title:        pr.Summary,
+			Description:  pr.Description,
+			Author:       pr.CreatedUser.Name,
+			CreatedAt:    createdAt,
+			MergedAt:     pr.Merged,
+			Labels:       []string{}, // Backlog doesn't have labels on PRs
+			HTMLURL:      fmt.Sprintf("https://%s.backlog.com/git/%s/%s/pullRequests/%d", c.spaceID, projectKey, repoName, pr.Number),
+			Status:       status,
+			SourceBranch: pr.Branch,
+			TargetBranch: pr.Base,
+		}
+
+		if pr.Issue != nil && pr.Issue.IssueKey != "" {
+			prData.LinkedIssues = []*platform.IssueData{{
+				Key:    pr.Issue.IssueKey,
+				Title:  pr.Issue.Summary,
+				Type:   pr.Issue.IssueType.Name,
+				Labels: []string{},
+				Status: pr.Issue.Status.Name,
+			}}
 		}
 
 		prs = append(prs, prData)
@@ -265,6 +309,12 @@ func (c *Client) GetPullRequestReviews(ctx context.Context, projectKey, repoName
 		CreatedUser struct {
 			Name string `json:"name"`
 		} `json:"createdUser"`
+		Stars []struct {
+			Presenter struct {
+				Name string `json:"name"`
+			} `json:"presenter"`
+			Created *time.Time `json:"created"`
+		} `json:"stars"`
 	}
 
 	if err := json.Unmarshal(body, &comments); err != nil {
@@ -280,6 +330,18 @@ func (c *Client) GetPullRequestReviews(ctx context.Context, projectKey, repoName
 			CommentBody:   comment.Content,
 		}
 		reviews = append(reviews, reviewData)
+
+		if !c.starsAsApprovals {
+			continue
+		}
+		// Backlog has no approve action: by team convention, a star given on a PR comment is an approval
+		for _, star := range comment.Stars {
+			reviews = append(reviews, &platform.ReviewData{
+				ReviewerLogin: star.Presenter.Name,
+				State:         "APPROVED",
+				SubmittedAt:   star.Created,
+			})
+		}
 	}
 
 	return reviews, nil
@@ -320,6 +382,205 @@ func (c *Client) GetPullRequestReviewsConcurrent(ctx context.Context, projectKey
 	return results, nil
 }
 
+// GetPullRequestStatsConcurrent returns no statistics: the Backlog API exposes neither
+// the diff nor the commits of a pull request
+func (c *Client) GetPullRequestStatsConcurrent(ctx context.Context, projectKey, repoName string, prNumbers []int, maxWorkers int) (map[int]*platform.PullRequestStats, error) {
+	return make(map[int]*platform.PullRequestStats), nil
+}
+
+// GetPullRequestFilesConcurrent returns no files: the Backlog API doesn't expose the diff of a pull request
+func (c *Client) GetPullRequestFilesConcurrent(ctx context.Context, projectKey, repoName string, prNumbers []int, maxWorkers int) (map[int][]string, error) {
+	return make(map[int][]string), nil
+}
+
+// GetIssue retrieves a Backlog issue by key, e.g. "PROJ-123"
+func (c *Client) GetIssue(ctx context.Context, issueKey string) (*platform.IssueData, error) {
+	body, err := c.doRequest(ctx, "GET", "/issues/"+url.PathEscape(issueKey), nil)
+	if err != nil {
+		return nil, fmt.Errorf("lỗi khi lấy issue %s: %w", issueKey, err)
+	}
+
+	var issue struct {
+		IssueKey  string `json:"issueKey"`
+		Summary   string `json:"summary"`
+		IssueType struct {
+			Name string `json:"name"`
+		} `json:"issueType"`
+		Status struct {
+			Name string `json:"name"`
+		} `json:"status"`
+		Category []struct {
+			Name string `json:"name"`
+		} `json:"category"`
+	}
+
+	if err := json.Unmarshal(body, &issue); err != nil {
+		return nil, err
+	}
+
+	// Backlog has no labels on issues; categories play the same role
+	labels := make([]string, 0, len(issue.Category))
+	for _, category := range issue.Category {
+		labels = append(labels, category.Name)
+	}
+
+	return &platform.IssueData{
+		Key:    issueKey,
+		Title:  issue.Summary,
+		Type:   issue.IssueType.Name,
+		Labels: labels,
+		Status: issue.Status.Name,
+	}, nil
+}
+
+// GetIssuesConcurrent resolves "PROJ-123" references to Backlog issues concurrently.
+// Issues of any project of the space can be linked, so the repository is not used.
+func (c *Client) GetIssuesConcurrent(ctx context.Context, projectKey, repoName string, refs []string, maxWorkers int) (map[string]*platform.IssueData, error) {
+	if maxWorkers <= 0 {
+		maxWorkers = 5
+	}
+
+	results := make(map[string]*platform.IssueData)
+	resultsMutex := &sync.Mutex{}
+
+	semaphore := make(chan struct{}, maxWorkers)
+	var wg sync.WaitGroup
+
+	for _, ref := range refs {
+		if strings.HasPrefix(ref, "#") {
+			continue // Backlog issues are referenced by key
+		}
+
+		wg.Add(1)
+		go func(issueKey string) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			issue, err := c.GetIssue(ctx, issueKey)
+			if err != nil {
+				fmt.Printf("⚠️  Error fetching issue %s: %v\n", issueKey, err)
+				return
+			}
+
+			resultsMutex.Lock()
+			results[issueKey] = issue
+			resultsMutex.Unlock()
+		}(ref)
+	}
+
+	wg.Wait()
+	return results, nil
+}
+
+// backlogStatusClosed is the ID of the built-in "Closed" (完了) status
+const backlogStatusClosed = 4
+
+// GetBugIssues retrieves the issues of the given types (e.g. "Bug", "バグ") created in a project within a time range.
+// Backlog has no resolution date, so closed issues are considered resolved at their last update.
+func (c *Client) GetBugIssues(ctx context.Context, projectKey string, issueTypes []string, startDate, endDate time.Time) ([]*platform.IssueData, error) {
+	body, err := c.doRequest(ctx, "GET", "/projects/"+url.PathEscape(projectKey), nil)
+	if err != nil {
+		return nil, fmt.Errorf("lỗi khi lấy project %s: %w", projectKey, err)
+	}
+	var project struct {
+		ID int `json:"id"`
+	}
+	if err := json.Unmarshal(body, &project); err != nil {
+		return nil, err
+	}
+
+	body, err = c.doRequest(ctx, "GET", "/projects/"+url.PathEscape(projectKey)+"/issueTypes", nil)
+	if err != nil {
+		return nil, fmt.Errorf("lỗi khi lấy issue types của %s: %w", projectKey, err)
+	}
+	var types []struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(body, &types); err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	params.Add("projectId[]", fmt.Sprint(project.ID))
+	for _, issueType := range types {
+		for _, name := range issueTypes {
+			if strings.EqualFold(strings.TrimSpace(name), issueType.Name) {
+				params.Add("issueTypeId[]", fmt.Sprint(issueType.ID))
+				break
+			}
+		}
+	}
+	if len(params["issueTypeId[]"]) == 0 {
+		return []*platform.IssueData{}, nil // The project has no bug issue type
+	}
+	params.Set("createdSince", startDate.Format("2006-01-02"))
+	// EndDate is exclusive, createdUntil is inclusive
+	params.Set("createdUntil", endDate.AddDate(0, 0, -1).Format("2006-01-02"))
+	params.Set("count", "100")
+
+	var issues []*platform.IssueData
+	for offset := 0; ; offset += 100 {
+		params.Set("offset", fmt.Sprint(offset))
+		body, err := c.doRequest(ctx, "GET", "/issues", params)
+		if err != nil {
+			return nil, fmt.Errorf("lỗi khi lấy issues của %s: %w", projectKey, err)
+		}
+
+		var page []struct {
+			IssueKey  string `json:"issueKey"`
+			Summary   string `json:"summary"`
+			IssueType struct {
+				Name string `json:"name"`
+			} `json:"issueType"`
+			Status struct {
+				ID   int    `json:"id"`
+				Name string `json:"name"`
+			} `json:"status"`
+			Assignee *struct {
+				Name string `json:"name"`
+			} `json:"assignee"`
+			Category []struct {
+				Name string `json:"name"`
+			} `json:"category"`
+			Created *time.Time `json:"created"`
+			Updated *time.Time `json:"updated"`
+		}
+		if err := json.Unmarshal(body, &page); err != nil {
+			return nil, err
+		}
+
+		for _, issue := range page {
+			issueData := &platform.IssueData{
+				Key:       issue.IssueKey,
+				Title:     issue.Summary,
+				Type:      issue.IssueType.Name,
+				Labels:    make([]string, 0, len(issue.Category)),
+				Status:    issue.Status.Name,
+				Project:   projectKey,
+				CreatedAt: issue.Created,
+			}
+			for _, category := range issue.Category {
+				issueData.Labels = append(issueData.Labels, category.Name)
+			}
+			if issue.Assignee != nil {
+				issueData.Assignee = issue.Assignee.Name
+			}
+			if issue.Status.ID == backlogStatusClosed {
+				issueData.ResolvedAt = issue.Updated
+			}
+			issues = append(issues, issueData)
+		}
+
+		if len(page) < 100 {
+			break
+		}
+	}
+
+	return issues, nil
+}
+
 // GetPullRequestsFromRepositoriesConcurrent fetches PRs from multiple repositories concurrently
 func (c *Client) GetPullRequestsFromRepositoriesConcurrent(ctx context.Context, repos []string, startDate, endDate time.Time, maxWorkers int) ([]platform.RepositoryScanJob, error) {
 	if maxWorkers <= 0 {
@@ -218,6 +218,7 @@ func (c *Client) GetPullRequests(ctx context.Context, owner, repo string, startD
 				State       string `json:"state"`
 				Author      struct {
 					DisplayName string `json:"display_name"`
+					Type        string `json:"type"` // "app_user" for apps and bots
 				} `json:"author"`
 				CreatedOn time.Time  `json:"created_on"`
 				UpdatedOn time.Time  `json:"updated_on"`
@@ -227,6 +228,16 @@ func (c *Client) GetPullRequests(ctx context.Context, owner, repo string, startD
 						Href string `json:"href"`
 					} `json:"html"`
 				} `json:"links"`
+				Source struct {
+					Branch struct {
+						Name string `json:"name"`
+					} `json:"branch"`
+				} `json:"source"`
+				Destination struct {
+					Branch struct {
+						Name string `json:"name"`
+					} `json:"branch"`
+				} `json:"destination"`
 			} `json:"values"`
 			Next string `json:"next"`
 		}
@@ -247,15 +258,19 @@ func (c *Client) GetPullRequests(ctx context.Context, owner, repo string, startD
 			}
 
 			prData := &platform.PullRequestData{
-				Number:      pr.ID,
-				Title:       pr.Title,
-				Description: pr.Description,
-				Author:      pr.Author.DisplayName,
-				CreatedAt:   pr.CreatedOn,
-				MergedAt:    pr.MergedOn,
-				Labels:      []string{}, // Bitbucket doesn't have labels on PRs by default
-				HTMLURL:     pr.Links.HTML.Href,
-				Status:      status,
+				Repository:   owner + "/" + repo,
+				Number:       pr.ID,
+				Title:        pr.Title,
+				Description:  pr.Description,
+				Author:       pr.Author.DisplayName,
+				AuthorIsBot:  pr.Author.Type == "app_user",
+				CreatedAt:    pr.CreatedOn,
+				MergedAt:     pr.MergedOn,
+				Labels:       []string{}, // Bitbucket doesn't have labels on PRs by default
+				HTMLURL:      pr.Links.HTML.Href,
+				Status:       status,
+				SourceBranch: pr.Source.Branch.Name,
+				TargetBranch: pr.Destination.Branch.Name,
 			}
 
 			prs = append(prs, prData)
@@ -308,6 +323,59 @@ func (c *Client) GetPullRequestReviews(ctx context.Context, owner, repo string,
 		urlPath = response.Next
 	}
 
+	// Comments are still returned when the approvals cannot be fetched
+	approvals, err := c.getParticipantReviews(ctx, owner, repo, prNumber)
+	if err != nil {
+		fmt.Printf("⚠️  Error fetching approvals for PR #%d: %v\n", prNumber, err)
+	}
+	reviews = append(reviews, approvals...)
+
+	return reviews, nil
+}
+
+// getParticipantReviews maps the approval status of the PR participants to reviews.
+// Bitbucket has no review objects: participants approve or request changes on the PR itself.
+func (c *Client) getParticipantReviews(ctx context.Context, owner, repo string, prNumber int) ([]*platform.ReviewData, error) {
+	urlPath := fmt.Sprintf("%s/repositories/%s/%s/pullrequests/%d", bitbucketAPIURL, owner, repo, prNumber)
+
+	body, err := c.doRequest(ctx, "GET", urlPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Participants []struct {
+			User struct {
+				DisplayName string `json:"display_name"`
+			} `json:"user"`
+			Approved bool   `json:"approved"`
+			State    string `json:"state"` // "approved", "changes_requested" or empty
+		} `json:"participants"`
+	}
+
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, err
+	}
+
+	var reviews []*platform.ReviewData
+	for _, participant := range response.Participants {
+		state := ""
+		switch {
+		case participant.Approved || participant.State == "approved":
+			state = "APPROVED"
+		case participant.State == "changes_requested":
+			state = "CHANGES_REQUESTED"
+		default:
+			continue
+		}
+
+		// participated_on is the participant's last activity, not the approval time: the time is left unknown
+		reviews = append(reviews, &platform.ReviewData{
+			ReviewerLogin: participant.User.DisplayName,
+			State:         state,
+		})
+	}
+
 	return reviews, nil
 }
 
@@ -346,6 +414,184 @@ func (c *Client) GetPullRequestReviewsConcurrent(ctx context.Context, owner, rep
 	return results, nil
 }
 
+// diffstatEntry is one changed file of a pull request diffstat
+type diffstatEntry struct {
+	LinesAdded   int `json:"lines_added"`
+	LinesRemoved int `json:"lines_removed"`
+	Old          *struct {
+		Path string `json:"path"`
+	} `json:"old"`
+	New *struct {
+		Path string `json:"path"`
+	} `json:"new"`
+}
+
+// path returns the path of the changed file, its old path if it was deleted
+func (e diffstatEntry) path() string {
+	if e.New != nil {
+		return e.New.Path
+	}
+	if e.Old != nil {
+		return e.Old.Path
+	}
+	return ""
+}
+
+// getDiffstat retrieves the per-file diff statistics of a pull request
+func (c *Client) getDiffstat(ctx context.Context, owner, repo string, prNumber int) ([]diffstatEntry, error) {
+	var entries []diffstatEntry
+	urlPath := fmt.Sprintf("%s/repositories/%s/%s/pullrequests/%d/diffstat", bitbucketAPIURL, owner, repo, prNumber)
+
+	for urlPath != "" {
+		body, err := c.doRequest(ctx, "GET", urlPath)
+		if err != nil {
+			return nil, fmt.Errorf("lỗi khi lấy diffstat từ PR %d: %w", prNumber, err)
+		}
+
+		var response struct {
+			Values []diffstatEntry `json:"values"`
+			Next   string          `json:"next"`
+		}
+
+		if err := json.Unmarshal(body, &response); err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, response.Values...)
+		urlPath = response.Next
+	}
+
+	return entries, nil
+}
+
+// GetPullRequestStats retrieves the diff statistics of a pull request from its diffstat and commits
+func (c *Client) GetPullRequestStats(ctx context.Context, owner, repo string, prNumber int) (*platform.PullRequestStats, error) {
+	entries, err := c.getDiffstat(ctx, owner, repo, prNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &platform.PullRequestStats{ChangedFiles: len(entries)}
+	for _, entry := range entries {
+		stats.Additions += entry.LinesAdded
+		stats.Deletions += entry.LinesRemoved
+	}
+
+	urlPath := fmt.Sprintf("%s/repositories/%s/%s/pullrequests/%d/commits", bitbucketAPIURL, owner, repo, prNumber)
+	for urlPath != "" {
+		body, err := c.doRequest(ctx, "GET", urlPath)
+		if err != nil {
+			return nil, fmt.Errorf("lỗi khi lấy commits từ PR %d: %w", prNumber, err)
+		}
+
+		var response struct {
+			Values []json.RawMessage `json:"values"`
+			Next   string            `json:"next"`
+		}
+
+		if err := json.Unmarshal(body, &response); err != nil {
+			return nil, err
+		}
+
+		stats.Commits += len(response.Values)
+		urlPath = response.Next
+	}
+
+	return stats, nil
+}
+
+// GetPullRequestStatsConcurrent retrieves diff statistics for multiple PRs concurrently
+func (c *Client) GetPullRequestStatsConcurrent(ctx context.Context, owner, repo string, prNumbers []int, maxWorkers int) (map[int]*platform.PullRequestStats, error) {
+	if maxWorkers <= 0 {
+		maxWorkers = 5
+	}
+
+	results := make(map[int]*platform.PullRequestStats)
+	resultsMutex := &sync.Mutex{}
+
+	semaphore := make(chan struct{}, maxWorkers)
+	var wg sync.WaitGroup
+
+	for _, prNumber := range prNumbers {
+		wg.Add(1)
+		go func(prNum int) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			stats, err := c.GetPullRequestStats(ctx, owner, repo, prNum)
+			if err != nil {
+				fmt.Printf("⚠️  Error fetching stats for PR #%d: %v\n", prNum, err)
+				return
+			}
+
+			resultsMutex.Lock()
+			results[prNum] = stats
+			resultsMutex.Unlock()
+		}(prNumber)
+	}
+
+	wg.Wait()
+	return results, nil
+}
+
+// GetPullRequestFiles retrieves the paths of the files changed by a pull request from its diffstat
+func (c *Client) GetPullRequestFiles(ctx context.Context, owner, repo string, prNumber int) ([]string, error) {
+	entries, err := c.getDiffstat(ctx, owner, repo, prNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if path := entry.path(); path != "" {
+			files = append(files, path)
+		}
+	}
+
+	return files, nil
+}
+
+// GetPullRequestFilesConcurrent retrieves the changed files of multiple PRs concurrently
+func (c *Client) GetPullRequestFilesConcurrent(ctx context.Context, owner, repo string, prNumbers []int, maxWorkers int) (map[int][]string, error) {
+	if maxWorkers <= 0 {
+		maxWorkers = 5
+	}
+
+	results := make(map[int][]string)
+	resultsMutex := &sync.Mutex{}
+
+	semaphore := make(chan struct{}, maxWorkers)
+	var wg sync.WaitGroup
+
+	for _, prNumber := range prNumbers {
+		wg.Add(1)
+		go func(prNum int) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			files, err := c.GetPullRequestFiles(ctx, owner, repo, prNum)
+			if err != nil {
+				fmt.Printf("⚠️  Error fetching files for PR #%d: %v\n", prNum, err)
+				return
+			}
+
+			resultsMutex.Lock()
+			results[prNum] = files
+			resultsMutex.Unlock()
+		}(prNumber)
+	}
+
+	wg.Wait()
+	return results, nil
+}
+
+// GetIssuesConcurrent resolves no issue: Bitbucket Cloud teams track issues in Jira
+func (c *Client) GetIssuesConcurrent(ctx context.Context, owner, repo string, refs []string, maxWorkers int) (map[string]*platform.IssueData, error) {
+	return make(map[string]*platform.IssueData), nil
+}
+
 // GetPullRequestsFromRepositoriesConcurrent fetches PRs from multiple repositories concurrently
 func (c *Client) GetPullRequestsFromRepositoriesConcurrent(ctx context.Context, repos []string, startDate, endDate time.Time, maxWorkers int) ([]platform.RepositoryScanJob, error) {
 	if maxWorkers <= 0 {
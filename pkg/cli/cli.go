@@ -474,8 +474,11 @@ func (c *CLI) PromptSelectBugType() (string, error) {
 	prompt := promptui.Select{
 		Label: "Chọn loại bug để scan",
 		Items: []string{
-			"1. Scan bug (từ labels)",
+			"1. Scan bug (từ labels, type: bug, tên branch)",
 			"2. Scan bug_review",
+			"3. Scan bug theo tiêu đề PR (Conventional Commits: fix(scope): ...)",
+			"4. Scan kết hợp (tất cả tín hiệu, có điểm tin cậy)",
+			"5. Scan theo issue liên kết (loại issue trên GitHub Issues/Backlog)",
 		},
 	}
 
@@ -484,10 +487,74 @@ func (c *CLI) PromptSelectBugType() (string, error) {
 		return "", err
 	}
 
-	if index == 0 {
+	switch index {
+	case 0:
 		return "bug", nil
+	case 1:
+		return "bug_review", nil
+	case 2:
+		return "title", nil
+	case 3:
+		return "combined", nil
+	default:
+		return "issue", nil
+	}
+}
+
+// PromptFetchStats asks whether diff statistics should be fetched in bug mode to compute the bug ratio per PR size
+func (c *CLI) PromptFetchStats() (bool, error) {
+	prompt := promptui.Select{
+		Label: "Lấy thêm thống kê kích thước PR (additions, deletions, files, commits)? (chậm hơn)",
+		Items: []string{"Có", "Không"},
 	}
-	return "bug_review", nil
+
+	_, result, err := prompt.Run()
+	return result == "Có", err
+}
+
+// PromptFetchFiles asks whether the changed files of bug-related PRs should be fetched to find bug hotspots
+func (c *CLI) PromptFetchFiles() (bool, error) {
+	prompt := promptui.Select{
+		Label: "Lấy danh sách file thay đổi của các PR bug để tìm hotspot? (chậm hơn)",
+		Items: []string{"Có", "Không"},
+	}
+
+	_, result, err := prompt.Run()
+	return result == "Có", err
+}
+
+// PromptFetchBugIssues asks whether the bug issues of the scanned projects should be reported (Backlog)
+func (c *CLI) PromptFetchBugIssues() (bool, error) {
+	prompt := promptui.Select{
+		Label: "Thống kê thêm các issue bug của project (số lượng, status, assignee, thời gian xử lý)?",
+		Items: []string{"Có", "Không"},
+	}
+
+	_, result, err := prompt.Run()
+	return result == "Có", err
+}
+
+// PromptFetchReviewTags asks whether reviews should be fetched in bug_review and combined modes
+// to read the bug_review tags of review comments (also used for lead-time metrics)
+func (c *CLI) PromptFetchReviewTags() (bool, error) {
+	prompt := promptui.Select{
+		Label: "Lấy thêm reviews để đọc tag bug_review trong review comment và tính lead time? (chậm hơn)",
+		Items: []string{"Có", "Không"},
+	}
+
+	_, result, err := prompt.Run()
+	return result == "Có", err
+}
+
+// PromptFetchReviews asks whether reviews should be fetched in bug mode to compute lead-time metrics
+func (c *CLI) PromptFetchReviews() (bool, error) {
+	prompt := promptui.Select{
+		Label: "Lấy thêm reviews để tính lead time (first review, approval)? (chậm hơn)",
+		Items: []string{"Có", "Không"},
+	}
+
+	_, result, err := prompt.Run()
+	return result == "Có", err
 }
 
 // PromptSelectPlatform prompts user to select Git platform
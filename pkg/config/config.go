@@ -0,0 +1,59 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/bug-crawler/pkg/analyzer"
+	"github.com/bug-crawler/pkg/backlog"
+	"github.com/bug-crawler/pkg/jira"
+)
+
+// Config contains the optional settings loaded from the config file.
+// Every section is optional; built-in defaults are used for missing sections.
+type Config struct {
+	PRRules      *analyzer.RuleConfig         `json:"pr_rules,omitempty"`
+	Authors      *analyzer.AuthorFilterConfig `json:"authors,omitempty"`
+	BugDetection *analyzer.BugDetectionConfig `json:"bug_detection,omitempty"`
+	Hotspots     *analyzer.HotspotConfig      `json:"hotspots,omitempty"`
+	Locales      *analyzer.LocaleConfig       `json:"locales,omitempty"`
+	Escape       *analyzer.EscapeConfig       `json:"escape,omitempty"`
+	Jira         *jira.Config                 `json:"jira,omitempty"`
+	Backlog      *backlog.Config              `json:"backlog,omitempty"`
+
+	path string // File the config was loaded from, empty if no file was found
+}
+
+// DefaultPath returns the config file path: $BUG_CRAWLER_CONFIG if set, otherwise ~/.config/bug-crawler/config.json
+func DefaultPath() string {
+	if envPath := os.Getenv("BUG_CRAWLER_CONFIG"); envPath != "" {
+		return envPath
+	}
+	return filepath.Join(os.Getenv("HOME"), ".config", "bug-crawler", "config.json")
+}
+
+// Load reads the config file. A missing file is not an error and yields an empty Config.
+func Load(filename string) (*Config, error) {
+	data, err := os.ReadFile(filename)
+	if errors.Is(err, os.ErrNotExist) {
+		return &Config{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &Config{path: filename}
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("file config %s không đúng định dạng JSON: %w", filename, err)
+	}
+
+	return cfg, nil
+}
+
+// Path returns the file the config was loaded from, or an empty string if defaults are used
+func (c *Config) Path() string {
+	return c.path
+}
@@ -0,0 +1,84 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoad_MissingFile(t *testing.T) {
+	cfg, err := Load(filepath.Join(t.TempDir(), "missing.json"))
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if cfg.PRRules != nil || cfg.Path() != "" {
+		t.Errorf("Expected empty config, got %+v", cfg)
+	}
+}
+
+func TestLoad_PRRules(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "config.json")
+	content := `{
+  "pr_rules": {
+    "rule_sets": [
+      {"name": "summary", "target": "description", "min_matches": 1,
+       "items": [{"keyword": "Summary", "patterns": ["summary|tl;dr"], "required": true}]}
+    ],
+    "repositories": {
+      "org/legacy": [{"name": "summary", "disabled": true}]
+    }
+  }
+}`
+	if err := os.WriteFile(filename, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := Load(filename)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	if cfg.Path() != filename {
+		t.Errorf("Path = %q, want %q", cfg.Path(), filename)
+	}
+	if len(cfg.PRRules.RuleSets) != 1 || !cfg.PRRules.RuleSets[0].Items[0].Required {
+		t.Errorf("Rule sets not loaded: %+v", cfg.PRRules.RuleSets)
+	}
+	if !cfg.PRRules.Repositories["org/legacy"][0].Disabled {
+		t.Errorf("Repository override not loaded: %+v", cfg.PRRules.Repositories)
+	}
+}
+
+func TestLoad_InvalidJSON(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(filename, []byte("{invalid"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Load(filename); err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestDefaultPath_Env(t *testing.T) {
+	t.Setenv("BUG_CRAWLER_CONFIG", "/tmp/custom.json")
+	if got := DefaultPath(); got != "/tmp/custom.json" {
+		t.Errorf("DefaultPath = %q, want /tmp/custom.json", got)
+	}
+}
+
+func TestLoad_Jira(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "config.json")
+	content := `{"jira": {"base_url": "https://team.atlassian.net", "email": "dev@example.com"}}`
+	if err := os.WriteFile(filename, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := Load(filename)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if cfg.Jira == nil || cfg.Jira.BaseURL != "https://team.atlassian.net" || cfg.Jira.Email != "dev@example.com" {
+		t.Errorf("Jira config not loaded: %+v", cfg.Jira)
+	}
+}
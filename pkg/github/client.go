@@ -3,6 +3,8 @@ package github
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -69,15 +71,19 @@ func (c *Client) GetPullRequests(ctx context.Context, owner, repo string, startD
 			}
 
 			prData := &platform.PullRequestData{
-				Number:      pr.GetNumber(),
-				Title:       pr.GetTitle(),
-				Description: pr.GetBody(),
-				Author:      pr.GetUser().GetLogin(),
-				CreatedAt:   pr.GetCreatedAt().Time,
-				MergedAt:    mergedAt,
-				Labels:      labels,
-				HTMLURL:     pr.GetHTMLURL(),
-				Status:      status,
+				Repository:   owner + "/" + repo,
+				Number:       pr.GetNumber(),
+				Title:        pr.GetTitle(),
+				Description:  pr.GetBody(),
+				Author:       pr.GetUser().GetLogin(),
+				AuthorIsBot:  pr.GetUser().GetType() == "Bot",
+				CreatedAt:    pr.GetCreatedAt().Time,
+				MergedAt:     mergedAt,
+				Labels:       labels,
+				HTMLURL:      pr.GetHTMLURL(),
+				Status:       status,
+				SourceBranch: pr.GetHead().GetRef(),
+				TargetBranch: pr.GetBase().GetRef(),
 			}
 
 			prs = append(prs, prData)
@@ -407,6 +413,204 @@ func (c *Client) GetPullRequestReviewsConcurrent(ctx context.Context, owner, rep
 	return results, nil
 }
 
+// GetPullRequestStats retrieves the diff statistics of a pull request from its detail
+func (c *Client) GetPullRequestStats(ctx context.Context, owner, repo string, prNumber int) (*platform.PullRequestStats, error) {
+	pr, _, err := c.client.PullRequests.Get(ctx, owner, repo, prNumber)
+	if err != nil {
+		return nil, fmt.Errorf("lỗi khi lấy chi tiết PR #%d: %w", prNumber, err)
+	}
+
+	return &platform.PullRequestStats{
+		Additions:    pr.GetAdditions(),
+		Deletions:    pr.GetDeletions(),
+		ChangedFiles: pr.GetChangedFiles(),
+		Commits:      pr.GetCommits(),
+	}, nil
+}
+
+// GetPullRequestStatsConcurrent retrieves diff statistics for multiple PRs concurrently
+func (c *Client) GetPullRequestStatsConcurrent(ctx context.Context, owner, repo string, prNumbers []int, maxWorkers int) (map[int]*platform.PullRequestStats, error) {
+	if maxWorkers <= 0 {
+		maxWorkers = 5 // Default worker pool size
+	}
+
+	results := make(map[int]*platform.PullRequestStats)
+	resultsMutex := &sync.Mutex{}
+
+	// Create semaphore to limit concurrent requests
+	semaphore := make(chan struct{}, maxWorkers)
+	var wg sync.WaitGroup
+
+	for _, prNumber := range prNumbers {
+		wg.Add(1)
+		go func(prNum int) {
+			defer wg.Done()
+			semaphore <- struct{}{}        // Acquire
+			defer func() { <-semaphore }() // Release
+
+			stats, err := c.GetPullRequestStats(ctx, owner, repo, prNum)
+			if err != nil {
+				fmt.Printf("⚠️  Error fetching stats for PR #%d: %v\n", prNum, err)
+				return
+			}
+
+			resultsMutex.Lock()
+			results[prNum] = stats
+			resultsMutex.Unlock()
+		}(prNumber)
+	}
+
+	wg.Wait()
+	return results, nil
+}
+
+// GetPullRequestFiles retrieves the paths of the files changed by a pull request
+func (c *Client) GetPullRequestFiles(ctx context.Context, owner, repo string, prNumber int) ([]string, error) {
+	files := make([]string, 0)
+	opts := &github.ListOptions{PerPage: 100}
+
+	for {
+		commitFiles, resp, err := c.client.PullRequests.ListFiles(ctx, owner, repo, prNumber, opts)
+		if err != nil {
+			return nil, fmt.Errorf("lỗi khi lấy files từ PR #%d: %w", prNumber, err)
+		}
+
+		for _, file := range commitFiles {
+			files = append(files, file.GetFilename())
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return files, nil
+}
+
+// GetPullRequestFilesConcurrent retrieves the changed files of multiple PRs concurrently
+func (c *Client) GetPullRequestFilesConcurrent(ctx context.Context, owner, repo string, prNumbers []int, maxWorkers int) (map[int][]string, error) {
+	if maxWorkers <= 0 {
+		maxWorkers = 5 // Default worker pool size
+	}
+
+	results := make(map[int][]string)
+	resultsMutex := &sync.Mutex{}
+
+	// Create semaphore to limit concurrent requests
+	semaphore := make(chan struct{}, maxWorkers)
+	var wg sync.WaitGroup
+
+	for _, prNumber := range prNumbers {
+		wg.Add(1)
+		go func(prNum int) {
+			defer wg.Done()
+			semaphore <- struct{}{}        // Acquire
+			defer func() { <-semaphore }() // Release
+
+			files, err := c.GetPullRequestFiles(ctx, owner, repo, prNum)
+			if err != nil {
+				fmt.Printf("⚠️  Error fetching files for PR #%d: %v\n", prNum, err)
+				return
+			}
+
+			resultsMutex.Lock()
+			results[prNum] = files
+			resultsMutex.Unlock()
+		}(prNumber)
+	}
+
+	wg.Wait()
+	return results, nil
+}
+
+// GetIssue retrieves an issue with its labels and issue type.
+// The issue type is not part of the go-github Issue struct, so the response is decoded directly.
+// The issues endpoint also returns pull requests: a number of a pull request is not resolved (nil, nil).
+func (c *Client) GetIssue(ctx context.Context, owner, repo string, number int) (*platform.IssueData, error) {
+	req, err := c.client.NewRequest("GET", fmt.Sprintf("repos/%s/%s/issues/%d", owner, repo, number), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var issue struct {
+		Title  string `json:"title"`
+		State  string `json:"state"`
+		Labels []struct {
+			Name string `json:"name"`
+		} `json:"labels"`
+		Type *struct {
+			Name string `json:"name"`
+		} `json:"type"`
+		PullRequest *struct{} `json:"pull_request"` // Set when the number is a pull request
+	}
+	if _, err := c.client.Do(ctx, req, &issue); err != nil {
+		return nil, fmt.Errorf("lỗi khi lấy issue #%d: %w", number, err)
+	}
+	if issue.PullRequest != nil {
+		return nil, nil
+	}
+
+	issueData := &platform.IssueData{
+		Key:    fmt.Sprintf("#%d", number),
+		Title:  issue.Title,
+		Labels: make([]string, 0, len(issue.Labels)),
+		Status: issue.State,
+	}
+	for _, label := range issue.Labels {
+		issueData.Labels = append(issueData.Labels, label.Name)
+	}
+	if issue.Type != nil {
+		issueData.Type = issue.Type.Name
+	}
+
+	return issueData, nil
+}
+
+// GetIssuesConcurrent resolves "#123" references to issues of the repository concurrently
+func (c *Client) GetIssuesConcurrent(ctx context.Context, owner, repo string, refs []string, maxWorkers int) (map[string]*platform.IssueData, error) {
+	if maxWorkers <= 0 {
+		maxWorkers = 5 // Default worker pool size
+	}
+
+	results := make(map[string]*platform.IssueData)
+	resultsMutex := &sync.Mutex{}
+
+	// Create semaphore to limit concurrent requests
+	semaphore := make(chan struct{}, maxWorkers)
+	var wg sync.WaitGroup
+
+	for _, ref := range refs {
+		number, err := strconv.Atoi(strings.TrimPrefix(ref, "#"))
+		if !strings.HasPrefix(ref, "#") || err != nil {
+			continue // Not a GitHub issue number
+		}
+
+		wg.Add(1)
+		go func(ref string, number int) {
+			defer wg.Done()
+			semaphore <- struct{}{}        // Acquire
+			defer func() { <-semaphore }() // Release
+
+			issue, err := c.GetIssue(ctx, owner, repo, number)
+			if err != nil {
+				fmt.Printf("⚠️  Error fetching issue %s: %v\n", ref, err)
+				return
+			}
+			if issue == nil {
+				return // Reference to a pull request
+			}
+
+			resultsMutex.Lock()
+			results[ref] = issue
+			resultsMutex.Unlock()
+		}(ref, number)
+	}
+
+	wg.Wait()
+	return results, nil
+}
+
 // GetPullRequestsFromRepositoriesConcurrent fetches PRs from multiple repositories concurrently
 func (c *Client) GetPullRequestsFromRepositoriesConcurrent(ctx context.Context, repos []string, startDate, endDate time.Time, maxWorkers int) ([]RepositoryScanJob, error) {
 	if maxWorkers <= 0 {
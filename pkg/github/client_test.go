@@ -0,0 +1,64 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/bug-crawler/pkg/analyzer"
+	"github.com/bug-crawler/pkg/platform"
+	"github.com/google/go-github/v56/github"
+)
+
+func newStubClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client := github.NewClient(nil)
+	baseURL, err := url.Parse(server.URL + "/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.BaseURL = baseURL
+	return &Client{client: client}
+}
+
+func TestGetIssuesConcurrent_SkipsPullRequests(t *testing.T) {
+	client := newStubClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/org/repo/issues/7":
+			_, _ = w.Write([]byte(`{"title":"Crash on login","state":"open","labels":[{"name":"bug"}],"type":{"name":"Bug"}}`))
+		case "/repos/org/repo/issues/12":
+			// A pull request with a bug label, as returned by the issues endpoint
+			_, _ = w.Write([]byte(`{"title":"Fix cache","state":"closed","labels":[{"name":"bug"}],"pull_request":{"url":"https://api.github.com/repos/org/repo/pulls/12"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	issues, err := client.GetIssuesConcurrent(context.Background(), "org", "repo", []string{"#7", "#12", "PROJ-1"}, 2)
+	if err != nil {
+		t.Fatalf("GetIssuesConcurrent() error = %v", err)
+	}
+	if len(issues) != 1 || issues["#7"] == nil || issues["#7"].Type != "Bug" {
+		t.Errorf("GetIssuesConcurrent() = %v, want only issue #7", issues)
+	}
+	if _, exists := issues["#12"]; exists {
+		t.Error("A reference to a pull request must not resolve as a linked issue")
+	}
+
+	// "Follow-up to #12" references a bug-labelled PR, not a bug issue
+	pr := &platform.PullRequestData{Repository: "org/repo", Number: 20, Title: "Tune cache size", Description: "Follow-up to #12"}
+	for _, ref := range analyzer.NewBugAnalyzer().ExtractIssueRefs(pr) {
+		if issue, exists := issues[ref]; exists {
+			pr.LinkedIssues = append(pr.LinkedIssues, issue)
+		}
+	}
+	results := analyzer.NewBugAnalyzer().AnalyzePRs([]*platform.PullRequestData{pr}, "issue", "github")
+	if len(results) != 1 || results[0].IsBugRelated || results[0].DetectionType == "linked_issue" {
+		t.Errorf("PR referencing a bug-labelled PR detected as %+v", results[0])
+	}
+}
@@ -0,0 +1,187 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bug-crawler/pkg/platform"
+)
+
+// issueKeyRegex matches Jira issue keys like "ABC-412"
+var issueKeyRegex = regexp.MustCompile(`^[A-Z][A-Z0-9_]+-\d+$`)
+
+// Config contains the Jira connection settings loaded from the config file.
+// The API token is not stored in the config file: it is read from $JIRA_API_TOKEN or ~/.config/bug-crawler/jira_token.
+type Config struct {
+	BaseURL string `json:"base_url"`        // e.g. https://your-team.atlassian.net or https://jira.company.com
+	Email   string `json:"email,omitempty"` // Jira Cloud account email; empty for Jira Server/Data Center personal access tokens
+}
+
+// Client resolves Jira issues. Lookups are cached for the lifetime of the client, so every issue
+// is fetched once per scan even if it is referenced from several repositories.
+type Client struct {
+	httpClient *http.Client
+	baseURL    string
+	email      string
+	apiToken   string
+
+	cacheMutex sync.Mutex
+	cache      map[string]*platform.IssueData // nil value: issue not found
+}
+
+// NewClient initializes Jira client. With an email, the token is sent with basic authentication (Jira Cloud),
+// otherwise as a bearer personal access token (Jira Server/Data Center).
+func NewClient(baseURL, email, apiToken string) (*Client, error) {
+	if baseURL == "" || apiToken == "" {
+		return nil, fmt.Errorf("jira base URL và API token là bắt buộc")
+	}
+	if _, err := url.ParseRequestURI(baseURL); err != nil {
+		return nil, fmt.Errorf("jira base URL không hợp lệ %q: %w", baseURL, err)
+	}
+
+	return &Client{
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		email:      email,
+		apiToken:   apiToken,
+		cache:      make(map[string]*platform.IssueData),
+	}, nil
+}
+
+// GetIssue retrieves a Jira issue by key. It returns nil without error if the issue doesn't exist
+// or isn't visible, since keys extracted from PRs ("UTF-8") are not always Jira issues.
+func (c *Client) GetIssue(ctx context.Context, key string) (*platform.IssueData, error) {
+	c.cacheMutex.Lock()
+	issue, cached := c.cache[key]
+	c.cacheMutex.Unlock()
+	if cached {
+		return issue, nil
+	}
+
+	issue, err := c.fetchIssue(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	c.cacheMutex.Lock()
+	c.cache[key] = issue
+	c.cacheMutex.Unlock()
+
+	return issue, nil
+}
+
+// fetchIssue calls the Jira REST API, available on both Cloud and Server
+func (c *Client) fetchIssue(ctx context.Context, key string) (*platform.IssueData, error) {
+	urlPath := fmt.Sprintf("%s/rest/api/2/issue/%s?fields=summary,issuetype,labels,status", c.baseURL, url.PathEscape(key))
+
+	req, err := http.NewRequestWithContext(ctx, "GET", urlPath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.email != "" {
+		req.SetBasicAuth(c.email, c.apiToken)
+	} else {
+		req.Header.Set("Authorization", "Bearer "+c.apiToken)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		if resp.StatusCode == http.StatusUnauthorized {
+			return nil, fmt.Errorf("jira API error: 401 unauthorized - kiểm tra lại email và API token")
+		}
+		return nil, fmt.Errorf("jira API error: %d - %s", resp.StatusCode, string(body))
+	}
+
+	var response struct {
+		Key    string `json:"key"`
+		Fields struct {
+			Summary   string `json:"summary"`
+			IssueType struct {
+				Name string `json:"name"`
+			} `json:"issuetype"`
+			Labels []string `json:"labels"`
+			Status struct {
+				Name string `json:"name"`
+			} `json:"status"`
+		} `json:"fields"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, err
+	}
+
+	labels := response.Fields.Labels
+	if labels == nil {
+		labels = []string{}
+	}
+
+	return &platform.IssueData{
+		Key:    key,
+		Title:  response.Fields.Summary,
+		Type:   response.Fields.IssueType.Name,
+		Labels: labels,
+		Status: response.Fields.Status.Name,
+	}, nil
+}
+
+// GetIssuesConcurrent resolves Jira issue keys concurrently. References that are not
+// issue keys ("#123") or don't exist in Jira are left out of the result.
+func (c *Client) GetIssuesConcurrent(ctx context.Context, refs []string, maxWorkers int) (map[string]*platform.IssueData, error) {
+	if maxWorkers <= 0 {
+		maxWorkers = 5
+	}
+
+	results := make(map[string]*platform.IssueData)
+	resultsMutex := &sync.Mutex{}
+
+	semaphore := make(chan struct{}, maxWorkers)
+	var wg sync.WaitGroup
+
+	for _, ref := range refs {
+		if !issueKeyRegex.MatchString(ref) {
+			continue
+		}
+
+		wg.Add(1)
+		go func(key string) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			issue, err := c.GetIssue(ctx, key)
+			if err != nil {
+				fmt.Printf("⚠️  Error fetching Jira issue %s: %v\n", key, err)
+				return
+			}
+			if issue == nil {
+				return
+			}
+
+			resultsMutex.Lock()
+			results[key] = issue
+			resultsMutex.Unlock()
+		}(ref)
+	}
+
+	wg.Wait()
+	return results, nil
+}
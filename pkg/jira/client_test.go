@@ -0,0 +1,117 @@
+package jira
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func newStubServer(t *testing.T, requests *int32) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+
+		if email, token, ok := r.BasicAuth(); !ok || email != "dev@example.com" || token != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/rest/api/2/issue/ABC-412":
+			_, _ = w.Write([]byte(`{"key":"ABC-412","fields":{"summary":"Crash on login","issuetype":{"name":"Bug"},"labels":["regression"],"status":{"name":"Done"}}}`))
+		case "/rest/api/2/issue/ABC-7":
+			_, _ = w.Write([]byte(`{"key":"ABC-7","fields":{"summary":"New page","issuetype":{"name":"Story"},"status":{"name":"Open"}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestClient_GetIssuesConcurrent(t *testing.T) {
+	var requests int32
+	server := newStubServer(t, &requests)
+	defer server.Close()
+
+	client, err := NewClient(server.URL+"/", "dev@example.com", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	refs := []string{"ABC-412", "ABC-7", "#12", "UTF-8"}
+	issues, err := client.GetIssuesConcurrent(context.Background(), refs, 2)
+	if err != nil {
+		t.Fatalf("GetIssuesConcurrent() error = %v", err)
+	}
+
+	if len(issues) != 2 {
+		t.Fatalf("GetIssuesConcurrent() returned %d issues, want 2: %v", len(issues), issues)
+	}
+	bug := issues["ABC-412"]
+	if bug.Type != "Bug" || bug.Title != "Crash on login" || bug.Status != "Done" || len(bug.Labels) != 1 || bug.Labels[0] != "regression" {
+		t.Errorf("ABC-412 = %+v", bug)
+	}
+	if issues["ABC-7"].Type != "Story" || issues["ABC-7"].Labels == nil {
+		t.Errorf("ABC-7 = %+v", issues["ABC-7"])
+	}
+
+	// "#12" is not a Jira key; "UTF-8" is looked up and not found
+	if got := atomic.LoadInt32(&requests); got != 3 {
+		t.Errorf("requests = %d, want 3", got)
+	}
+
+	// Lookups are cached, including issues that were not found
+	if _, err := client.GetIssuesConcurrent(context.Background(), refs, 2); err != nil {
+		t.Fatal(err)
+	}
+	if got := atomic.LoadInt32(&requests); got != 3 {
+		t.Errorf("requests after cached lookup = %d, want 3", got)
+	}
+}
+
+func TestClient_Unauthorized(t *testing.T) {
+	var requests int32
+	server := newStubServer(t, &requests)
+	defer server.Close()
+
+	client, err := NewClient(server.URL, "dev@example.com", "wrong")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.GetIssue(context.Background(), "ABC-412"); err == nil || !strings.Contains(err.Error(), "401") {
+		t.Errorf("GetIssue() error = %v, want 401", err)
+	}
+}
+
+func TestClient_BearerToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer pat" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"key":"OPS-1","fields":{"issuetype":{"name":"Bug"}}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL, "", "pat")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issue, err := client.GetIssue(context.Background(), "OPS-1")
+	if err != nil || issue == nil || issue.Type != "Bug" {
+		t.Errorf("GetIssue() = %+v, %v", issue, err)
+	}
+}
+
+func TestNewClient_Invalid(t *testing.T) {
+	if _, err := NewClient("", "a@b.c", "token"); err == nil {
+		t.Error("Expected error without base URL")
+	}
+	if _, err := NewClient("https://jira.example.com", "a@b.c", ""); err == nil {
+		t.Error("Expected error without token")
+	}
+}
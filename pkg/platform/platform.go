@@ -24,6 +24,18 @@ type Platform interface {
 
 	// GetPullRequestReviewsConcurrent retrieves reviews for multiple PRs concurrently
 	GetPullRequestReviewsConcurrent(ctx context.Context, owner, repo string, prNumbers []int, maxWorkers int) (map[int][]*ReviewData, error)
+
+	// GetPullRequestStatsConcurrent retrieves diff statistics for multiple PRs concurrently.
+	// PRs whose statistics are not available on the platform are left out of the result.
+	GetPullRequestStatsConcurrent(ctx context.Context, owner, repo string, prNumbers []int, maxWorkers int) (map[int]*PullRequestStats, error)
+
+	// GetPullRequestFilesConcurrent retrieves the paths of the files changed by multiple PRs concurrently.
+	// PRs whose files are not available on the platform are left out of the result.
+	GetPullRequestFilesConcurrent(ctx context.Context, owner, repo string, prNumbers []int, maxWorkers int) (map[int][]string, error)
+
+	// GetIssuesConcurrent resolves issue references ("#123", "PROJ-123") of a repository concurrently.
+	// References the platform can't resolve are left out of the result.
+	GetIssuesConcurrent(ctx context.Context, owner, repo string, refs []string, maxWorkers int) (map[string]*IssueData, error)
 }
 
 // RepositoryInfo contains repository information
@@ -42,18 +54,78 @@ type ReviewData struct {
 	CommentBody   string
 }
 
+// IssueData contains the tracker information of an issue linked to a pull request
+type IssueData struct {
+	Key    string // Reference as written in the PR: "#123", "PROJ-123"
+	Title  string
+	Type   string // Issue type, e.g. "Bug", "バグ"; empty if the tracker has none
+	Labels []string
+	Status string
+	// Tracker details, only filled by GetBugIssues
+	Project    string
+	Assignee   string
+	CreatedAt  *time.Time
+	ResolvedAt *time.Time // Approximated by the last update for closed issues when the tracker has no resolution date
+}
+
+// BugIssueSource is implemented by platforms with an issue tracker that can list bug issues (Backlog)
+type BugIssueSource interface {
+	// GetBugIssues retrieves the issues of the given types created in a project within a time range
+	GetBugIssues(ctx context.Context, projectKey string, issueTypes []string, startDate, endDate time.Time) ([]*IssueData, error)
+}
+
+// ApprovalSource is implemented by platforms whose reviews may not include approvals (Backlog).
+// Platforms not implementing it always report approvals.
+type ApprovalSource interface {
+	// ReportsApprovals reports whether approvals are included in the reviews
+	ReportsApprovals() bool
+}
+
+// PullRequestStats contains the diff statistics of a pull request
+type PullRequestStats struct {
+	Additions    int
+	Deletions    int
+	ChangedFiles int
+	Commits      int
+}
+
 // PullRequestData contains pull request information
 type PullRequestData struct {
+	Repository  string // Full repository name (owner/repo)
 	Number      int
 	Title       string
 	Description string
 	Author      string
+	AuthorIsBot bool // Author is an app or bot account according to the platform
 	CreatedAt   time.Time
 	MergedAt    *time.Time
 	Labels      []string
 	HTMLURL     string
 	Status      string
 	Reviews     []*ReviewData
+	// Branch names, e.g. "fix/login-crash" merged into "main"
+	SourceBranch string
+	TargetBranch string
+	// Diff statistics, zero until fetched with GetPullRequestStatsConcurrent
+	Additions    int
+	Deletions    int
+	ChangedFiles int
+	Commits      int
+	Files        []string     // Paths of the changed files, nil until fetched with GetPullRequestFilesConcurrent
+	LinkedIssues []*IssueData // Issues referenced by the PR, nil until resolved with GetIssuesConcurrent
+}
+
+// ApplyStats copies diff statistics into the pull request
+func (pr *PullRequestData) ApplyStats(stats *PullRequestStats) {
+	pr.Additions = stats.Additions
+	pr.Deletions = stats.Deletions
+	pr.ChangedFiles = stats.ChangedFiles
+	pr.Commits = stats.Commits
+}
+
+// HasStats reports whether diff statistics have been fetched for the pull request
+func (pr *PullRequestData) HasStats() bool {
+	return pr.ChangedFiles > 0 || pr.Additions > 0 || pr.Deletions > 0 || pr.Commits > 0
 }
 
 // RepositoryScanJob represents a job to scan a single repository
@@ -0,0 +1,461 @@
+package report
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/bug-crawler/pkg/analyzer"
+)
+
+// RatioDelta contains the bug ratio of one group (repository, author) in both periods
+type RatioDelta struct {
+	Key       string  `json:"key"`
+	BaseTotal int     `json:"base_total"`
+	BaseBugs  int     `json:"base_bugs"`
+	BaseRatio float64 `json:"base_ratio"` // Percentage
+	HeadTotal int     `json:"head_total"`
+	HeadBugs  int     `json:"head_bugs"`
+	HeadRatio float64 `json:"head_ratio"` // Percentage
+	Delta     float64 `json:"delta"`      // Percentage points (head - base)
+}
+
+// RuleDelta contains the compliance rate of one rule in both periods
+type RuleDelta struct {
+	Rule       string  `json:"rule"`
+	BasePassed int     `json:"base_passed"`
+	BaseTotal  int     `json:"base_total"`
+	BaseRate   float64 `json:"base_rate"` // Percentage
+	HeadPassed int     `json:"head_passed"`
+	HeadTotal  int     `json:"head_total"`
+	HeadRate   float64 `json:"head_rate"` // Percentage
+	Delta      float64 `json:"delta"`     // Percentage points (head - base)
+}
+
+// AuthorComplianceChange describes an author who has non-compliant PRs in the head period only
+type AuthorComplianceChange struct {
+	Author           string `json:"author"`
+	BaseTotal        int    `json:"base_total"` // 0 if the author had no PR in the base period
+	HeadTotal        int    `json:"head_total"`
+	HeadNonCompliant int    `json:"head_non_compliant"`
+}
+
+// Comparison contains the period-over-period diff between two scans
+type Comparison struct {
+	ScanMode                 string                    `json:"scan_mode"`
+	BasePeriod               string                    `json:"base_period"`
+	HeadPeriod               string                    `json:"head_period"`
+	Overall                  *RatioDelta               `json:"overall,omitempty"`
+	ByRepository             []*RatioDelta             `json:"by_repository,omitempty"`
+	ByAuthor                 []*RatioDelta             `json:"by_author,omitempty"`
+	ByRule                   []*RuleDelta              `json:"by_rule,omitempty"`
+	NewlyNonCompliantAuthors []*AuthorComplianceChange `json:"newly_non_compliant_authors,omitempty"`
+}
+
+// ratioCounter accumulates total and bug-related PRs of a group
+type ratioCounter struct {
+	total int
+	bugs  int
+}
+
+// Compare builds a period-over-period comparison of two scans of the same mode and bug type
+func Compare(base, head *ScanSnapshot) (*Comparison, error) {
+	if base.ScanMode != head.ScanMode {
+		return nil, fmt.Errorf("không thể so sánh 2 chế độ scan khác nhau: %s và %s", base.ScanMode, head.ScanMode)
+	}
+	if base.BugType != head.BugType {
+		return nil, fmt.Errorf("không thể so sánh 2 loại bug khác nhau: %s và %s", base.BugType, head.BugType)
+	}
+
+	comparison := &Comparison{
+		ScanMode:   head.ScanMode,
+		BasePeriod: base.PeriodLabel(),
+		HeadPeriod: head.PeriodLabel(),
+	}
+
+	if head.ScanMode == "pr_rules" {
+		comparison.ByRule = compareRules(base.PRRuleResults, head.PRRuleResults)
+		comparison.NewlyNonCompliantAuthors = newlyNonCompliantAuthors(base.PRRuleResults, head.PRRuleResults)
+		return comparison, nil
+	}
+
+	baseOverall, baseByRepo, baseByAuthor := countBugRatios(base.BugResults)
+	headOverall, headByRepo, headByAuthor := countBugRatios(head.BugResults)
+
+	comparison.Overall = newRatioDelta("", baseOverall, headOverall)
+	comparison.ByRepository = mergeRatioCounters(baseByRepo, headByRepo)
+	comparison.ByAuthor = mergeRatioCounters(baseByAuthor, headByAuthor)
+
+	return comparison, nil
+}
+
+// countBugRatios counts total and bug-related PRs overall, per repository and per author
+func countBugRatios(results []*analyzer.BugResult) (ratioCounter, map[string]*ratioCounter, map[string]*ratioCounter) {
+	var overall ratioCounter
+	byRepo := make(map[string]*ratioCounter)
+	byAuthor := make(map[string]*ratioCounter)
+
+	for _, result := range results {
+		for _, counter := range []*ratioCounter{
+			&overall,
+			getRatioCounter(byRepo, result.PR.Repository),
+			getRatioCounter(byAuthor, result.PR.Author),
+		} {
+			counter.total++
+			if result.IsBugRelated {
+				counter.bugs++
+			}
+		}
+	}
+
+	return overall, byRepo, byAuthor
+}
+
+// getRatioCounter returns the counter of a key, creating it if needed
+func getRatioCounter(counters map[string]*ratioCounter, key string) *ratioCounter {
+	counter, exists := counters[key]
+	if !exists {
+		counter = &ratioCounter{}
+		counters[key] = counter
+	}
+	return counter
+}
+
+// mergeRatioCounters joins the counters of both periods, sorted by the largest change first
+func mergeRatioCounters(base, head map[string]*ratioCounter) []*RatioDelta {
+	keys := make(map[string]bool)
+	for key := range base {
+		keys[key] = true
+	}
+	for key := range head {
+		keys[key] = true
+	}
+
+	deltas := make([]*RatioDelta, 0, len(keys))
+	for key := range keys {
+		var baseCounter, headCounter ratioCounter
+		if counter, exists := base[key]; exists {
+			baseCounter = *counter
+		}
+		if counter, exists := head[key]; exists {
+			headCounter = *counter
+		}
+		deltas = append(deltas, newRatioDelta(key, baseCounter, headCounter))
+	}
+
+	sort.Slice(deltas, func(i, j int) bool {
+		if deltas[i].Delta != deltas[j].Delta {
+			return deltas[i].Delta > deltas[j].Delta
+		}
+		return deltas[i].Key < deltas[j].Key
+	})
+
+	return deltas
+}
+
+// newRatioDelta creates a RatioDelta from the counters of both periods
+func newRatioDelta(key string, base, head ratioCounter) *RatioDelta {
+	delta := &RatioDelta{
+		Key:       key,
+		BaseTotal: base.total,
+		BaseBugs:  base.bugs,
+		BaseRatio: percentage(base.bugs, base.total),
+		HeadTotal: head.total,
+		HeadBugs:  head.bugs,
+		HeadRatio: percentage(head.bugs, head.total),
+	}
+	delta.Delta = delta.HeadRatio - delta.BaseRatio
+	return delta
+}
+
+// percentage returns part/total in percent, or 0 if total is 0
+func percentage(part, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(part) * 100 / float64(total)
+}
+
+// ruleOutcomes returns whether each compliance rule passed for a PR
+func ruleOutcomes(result *analyzer.PRRuleResult) map[string]bool {
+	outcomes := map[string]bool{
+		"pr_description": result.PRDescriptionValid,
+		"review_comment": result.ReviewCommentValid,
+		"pr_compliant":   result.PRCompliant,
+	}
+	for _, ruleResult := range result.RuleResults {
+		outcomes[ruleResult.Name] = ruleResult.Passed
+	}
+	return outcomes
+}
+
+// compareRules computes the compliance rate of every rule in both periods
+func compareRules(base, head []*analyzer.PRRuleResult) []*RuleDelta {
+	deltas := make(map[string]*RuleDelta)
+	getDelta := func(rule string) *RuleDelta {
+		delta, exists := deltas[rule]
+		if !exists {
+			delta = &RuleDelta{Rule: rule}
+			deltas[rule] = delta
+		}
+		return delta
+	}
+
+	for _, result := range base {
+		for rule, passed := range ruleOutcomes(result) {
+			delta := getDelta(rule)
+			delta.BaseTotal++
+			if passed {
+				delta.BasePassed++
+			}
+		}
+	}
+	for _, result := range head {
+		for rule, passed := range ruleOutcomes(result) {
+			delta := getDelta(rule)
+			delta.HeadTotal++
+			if passed {
+				delta.HeadPassed++
+			}
+		}
+	}
+
+	rules := make([]*RuleDelta, 0, len(deltas))
+	for _, delta := range deltas {
+		delta.BaseRate = percentage(delta.BasePassed, delta.BaseTotal)
+		delta.HeadRate = percentage(delta.HeadPassed, delta.HeadTotal)
+		delta.Delta = delta.HeadRate - delta.BaseRate
+		rules = append(rules, delta)
+	}
+
+	sort.Slice(rules, func(i, j int) bool { return rules[i].Rule < rules[j].Rule })
+	return rules
+}
+
+// newlyNonCompliantAuthors lists authors with non-compliant PRs in head but none in base
+func newlyNonCompliantAuthors(base, head []*analyzer.PRRuleResult) []*AuthorComplianceChange {
+	baseTotal := make(map[string]int)
+	baseNonCompliant := make(map[string]bool)
+	for _, result := range base {
+		baseTotal[result.PR.Author]++
+		if !result.PRCompliant {
+			baseNonCompliant[result.PR.Author] = true
+		}
+	}
+
+	changes := make(map[string]*AuthorComplianceChange)
+	for _, result := range head {
+		author := result.PR.Author
+		if baseNonCompliant[author] {
+			continue
+		}
+
+		change, exists := changes[author]
+		if !exists {
+			change = &AuthorComplianceChange{Author: author, BaseTotal: baseTotal[author]}
+			changes[author] = change
+		}
+		change.HeadTotal++
+		if !result.PRCompliant {
+			change.HeadNonCompliant++
+		}
+	}
+
+	authors := make([]*AuthorComplianceChange, 0)
+	for _, change := range changes {
+		if change.HeadNonCompliant > 0 {
+			authors = append(authors, change)
+		}
+	}
+
+	sort.Slice(authors, func(i, j int) bool {
+		if authors[i].HeadNonCompliant != authors[j].HeadNonCompliant {
+			return authors[i].HeadNonCompliant > authors[j].HeadNonCompliant
+		}
+		return authors[i].Author < authors[j].Author
+	})
+
+	return authors
+}
+
+// formatDelta formats a percentage point change with its sign
+func formatDelta(delta float64) string {
+	return fmt.Sprintf("%+.2f", delta)
+}
+
+// PrintComparison prints the period-over-period comparison
+func (r *Reporter) PrintComparison(c *Comparison) {
+	separator := "============================================================"
+	fmt.Println("\n" + separator)
+	fmt.Println("SO SÁNH GIỮA 2 KỲ")
+	fmt.Println(separator)
+	fmt.Printf("Kỳ trước: %s\n", c.BasePeriod)
+	fmt.Printf("Kỳ này:   %s\n", c.HeadPeriod)
+
+	if c.Overall != nil {
+		fmt.Printf("Tỷ lệ bug: %.2f%% → %.2f%% (%s điểm %%)\n", c.Overall.BaseRatio, c.Overall.HeadRatio, formatDelta(c.Overall.Delta))
+	}
+	fmt.Println(separator)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	if len(c.ByRepository) > 0 {
+		fmt.Println("\nTỶ LỆ BUG THEO REPOSITORY:")
+		printRatioDeltas(w, "REPOSITORY", c.ByRepository)
+	}
+
+	if len(c.ByAuthor) > 0 {
+		fmt.Println("\nTỶ LỆ BUG THEO AUTHOR:")
+		printRatioDeltas(w, "AUTHOR", c.ByAuthor)
+	}
+
+	if len(c.ByRule) > 0 {
+		fmt.Println("\nTỶ LỆ TUÂN THỦ THEO QUY TẮC:")
+		_, _ = fmt.Fprintln(w, "RULE\tKỲ TRƯỚC\tKỲ NÀY\tDELTA")
+		for _, rule := range c.ByRule {
+			_, _ = fmt.Fprintf(w, "%s\t%.1f%% (%d/%d)\t%.1f%% (%d/%d)\t%s\n",
+				rule.Rule,
+				rule.BaseRate, rule.BasePassed, rule.BaseTotal,
+				rule.HeadRate, rule.HeadPassed, rule.HeadTotal,
+				formatDelta(rule.Delta))
+		}
+		_ = w.Flush()
+	}
+
+	if c.ScanMode == "pr_rules" {
+		fmt.Println("\nAUTHOR MỚI KHÔNG TUÂN THỦ:")
+		if len(c.NewlyNonCompliantAuthors) == 0 {
+			fmt.Println("(Không có)")
+		} else {
+			_, _ = fmt.Fprintln(w, "AUTHOR\tPR KỲ TRƯỚC\tPR KỲ NÀY\tKHÔNG TUÂN THỦ")
+			for _, author := range c.NewlyNonCompliantAuthors {
+				_, _ = fmt.Fprintf(w, "%s\t%d\t%d\t%d\n", author.Author, author.BaseTotal, author.HeadTotal, author.HeadNonCompliant)
+			}
+			_ = w.Flush()
+		}
+	}
+
+	fmt.Println(separator)
+}
+
+// printRatioDeltas prints a table of bug ratio deltas
+func printRatioDeltas(w *tabwriter.Writer, keyHeader string, deltas []*RatioDelta) {
+	_, _ = fmt.Fprintf(w, "%s\tKỲ TRƯỚC\tKỲ NÀY\tDELTA\n", keyHeader)
+	for _, delta := range deltas {
+		_, _ = fmt.Fprintf(w, "%s\t%.2f%% (%d/%d)\t%.2f%% (%d/%d)\t%s\n",
+			delta.Key,
+			delta.BaseRatio, delta.BaseBugs, delta.BaseTotal,
+			delta.HeadRatio, delta.HeadBugs, delta.HeadTotal,
+			formatDelta(delta.Delta))
+	}
+	_ = w.Flush()
+}
+
+// ExportComparisonMarkdown exports the comparison as a Markdown document
+func (r *Reporter) ExportComparisonMarkdown(filename string, c *Comparison) error {
+	var b strings.Builder
+
+	b.WriteString("# So sánh giữa 2 kỳ\n\n")
+	fmt.Fprintf(&b, "- Kỳ trước: %s\n", c.BasePeriod)
+	fmt.Fprintf(&b, "- Kỳ này: %s\n", c.HeadPeriod)
+	if c.Overall != nil {
+		fmt.Fprintf(&b, "- Tỷ lệ bug: %.2f%% → %.2f%% (%s)\n", c.Overall.BaseRatio, c.Overall.HeadRatio, formatDelta(c.Overall.Delta))
+	}
+
+	writeRatioTable := func(title, keyHeader string, deltas []*RatioDelta) {
+		if len(deltas) == 0 {
+			return
+		}
+		fmt.Fprintf(&b, "\n## %s\n\n", title)
+		fmt.Fprintf(&b, "| %s | Kỳ trước | Kỳ này | Delta |\n", keyHeader)
+		b.WriteString("|---|---:|---:|---:|\n")
+		for _, delta := range deltas {
+			fmt.Fprintf(&b, "| %s | %.2f%% (%d/%d) | %.2f%% (%d/%d) | %s |\n",
+				delta.Key,
+				delta.BaseRatio, delta.BaseBugs, delta.BaseTotal,
+				delta.HeadRatio, delta.HeadBugs, delta.HeadTotal,
+				formatDelta(delta.Delta))
+		}
+	}
+
+	writeRatioTable("Tỷ lệ bug theo repository", "Repository", c.ByRepository)
+	writeRatioTable("Tỷ lệ bug theo author", "Author", c.ByAuthor)
+
+	if len(c.ByRule) > 0 {
+		b.WriteString("\n## Tỷ lệ tuân thủ theo quy tắc\n\n")
+		b.WriteString("| Rule | Kỳ trước | Kỳ này | Delta |\n")
+		b.WriteString("|---|---:|---:|---:|\n")
+		for _, rule := range c.ByRule {
+			fmt.Fprintf(&b, "| %s | %.1f%% (%d/%d) | %.1f%% (%d/%d) | %s |\n",
+				rule.Rule,
+				rule.BaseRate, rule.BasePassed, rule.BaseTotal,
+				rule.HeadRate, rule.HeadPassed, rule.HeadTotal,
+				formatDelta(rule.Delta))
+		}
+	}
+
+	if c.ScanMode == "pr_rules" {
+		b.WriteString("\n## Author mới không tuân thủ\n\n")
+		if len(c.NewlyNonCompliantAuthors) == 0 {
+			b.WriteString("_Không có_\n")
+		} else {
+			b.WriteString("| Author | PR kỳ trước | PR kỳ này | Không tuân thủ |\n")
+			b.WriteString("|---|---:|---:|---:|\n")
+			for _, author := range c.NewlyNonCompliantAuthors {
+				fmt.Fprintf(&b, "| %s | %d | %d | %d |\n", author.Author, author.BaseTotal, author.HeadTotal, author.HeadNonCompliant)
+			}
+		}
+	}
+
+	if err := os.WriteFile(filename, []byte(b.String()), 0644); err != nil {
+		return err
+	}
+
+	fmt.Printf("\nBáo cáo so sánh (Markdown) đã được export vào: %s\n", filename)
+	return nil
+}
+
+// ExportComparisonCSV exports the comparison to CSV, one row per compared group
+func (r *Reporter) ExportComparisonCSV(filename string, c *Comparison) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = file.Close() }()
+
+	_, _ = fmt.Fprintln(file, "section,key,base_count,base_total,base_value,head_count,head_total,head_value,delta")
+
+	writeRatioRows := func(section string, deltas []*RatioDelta) {
+		for _, delta := range deltas {
+			_, _ = fmt.Fprintf(file, "%s,\"%s\",%d,%d,%.2f,%d,%d,%.2f,%.2f\n",
+				section, delta.Key,
+				delta.BaseBugs, delta.BaseTotal, delta.BaseRatio,
+				delta.HeadBugs, delta.HeadTotal, delta.HeadRatio,
+				delta.Delta)
+		}
+	}
+
+	if c.Overall != nil {
+		writeRatioRows("overall", []*RatioDelta{c.Overall})
+	}
+	writeRatioRows("repository", c.ByRepository)
+	writeRatioRows("author", c.ByAuthor)
+
+	for _, rule := range c.ByRule {
+		_, _ = fmt.Fprintf(file, "rule,%s,%d,%d,%.2f,%d,%d,%.2f,%.2f\n",
+			rule.Rule,
+			rule.BasePassed, rule.BaseTotal, rule.BaseRate,
+			rule.HeadPassed, rule.HeadTotal, rule.HeadRate,
+			rule.Delta)
+	}
+
+	for _, author := range c.NewlyNonCompliantAuthors {
+		_, _ = fmt.Fprintf(file, "newly_non_compliant_author,\"%s\",,%d,,%d,%d,,\n",
+			author.Author, author.BaseTotal, author.HeadNonCompliant, author.HeadTotal)
+	}
+
+	fmt.Printf("\nBáo cáo so sánh (CSV) đã được export vào: %s\n", filename)
+	return nil
+}
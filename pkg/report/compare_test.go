@@ -0,0 +1,208 @@
+package report
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bug-crawler/pkg/analyzer"
+	"github.com/bug-crawler/pkg/platform"
+)
+
+func newBugResult(repo, author string, isBug bool) *analyzer.BugResult {
+	return &analyzer.BugResult{
+		PR:           &platform.PullRequestData{Repository: repo, Author: author},
+		IsBugRelated: isBug,
+	}
+}
+
+func newPRRuleResult(author string, descValid, reviewValid bool) *analyzer.PRRuleResult {
+	return &analyzer.PRRuleResult{
+		PR:                 &platform.PullRequestData{Repository: "org/api", Author: author},
+		PRDescriptionValid: descValid,
+		ReviewCommentValid: reviewValid,
+		PRCompliant:        descValid && reviewValid,
+	}
+}
+
+func TestCompare_BugRatios(t *testing.T) {
+	base := &ScanSnapshot{
+		ScanMode: "bug",
+		BugResults: []*analyzer.BugResult{
+			newBugResult("org/api", "alice", true),
+			newBugResult("org/api", "alice", false),
+			newBugResult("org/web", "bob", false),
+			newBugResult("org/web", "bob", false),
+		},
+	}
+	head := &ScanSnapshot{
+		ScanMode: "bug",
+		BugResults: []*analyzer.BugResult{
+			newBugResult("org/api", "alice", false),
+			newBugResult("org/web", "bob", true),
+			newBugResult("org/web", "carol", true),
+			newBugResult("org/web", "carol", false),
+		},
+	}
+
+	comparison, err := Compare(base, head)
+	if err != nil {
+		t.Fatalf("Compare failed: %v", err)
+	}
+
+	if comparison.Overall.BaseRatio != 25 || comparison.Overall.HeadRatio != 50 || comparison.Overall.Delta != 25 {
+		t.Errorf("Overall = %+v, want 25%% → 50%% (+25)", comparison.Overall)
+	}
+
+	repos := make(map[string]*RatioDelta)
+	for _, delta := range comparison.ByRepository {
+		repos[delta.Key] = delta
+	}
+	if repos["org/api"].Delta != -50 {
+		t.Errorf("org/api delta = %v, want -50", repos["org/api"].Delta)
+	}
+	if repos["org/web"].HeadRatio != float64(2)*100/3 {
+		t.Errorf("org/web head ratio = %v, want 66.67", repos["org/web"].HeadRatio)
+	}
+
+	// Sorted by largest increase first
+	if comparison.ByRepository[0].Key != "org/web" {
+		t.Errorf("First repository = %s, want org/web", comparison.ByRepository[0].Key)
+	}
+
+	authors := make(map[string]*RatioDelta)
+	for _, delta := range comparison.ByAuthor {
+		authors[delta.Key] = delta
+	}
+	if carol := authors["carol"]; carol == nil || carol.BaseTotal != 0 || carol.HeadRatio != 50 {
+		t.Errorf("carol = %+v, want new author with 50%% head ratio", carol)
+	}
+}
+
+func TestCompare_Compliance(t *testing.T) {
+	base := &ScanSnapshot{
+		ScanMode: "pr_rules",
+		PRRuleResults: []*analyzer.PRRuleResult{
+			newPRRuleResult("alice", true, true),
+			newPRRuleResult("bob", false, true),
+			newPRRuleResult("carol", true, true),
+		},
+	}
+	head := &ScanSnapshot{
+		ScanMode: "pr_rules",
+		PRRuleResults: []*analyzer.PRRuleResult{
+			newPRRuleResult("alice", true, false),
+			newPRRuleResult("bob", false, false),
+			newPRRuleResult("carol", true, true),
+			newPRRuleResult("dave", false, true),
+		},
+	}
+
+	comparison, err := Compare(base, head)
+	if err != nil {
+		t.Fatalf("Compare failed: %v", err)
+	}
+
+	rules := make(map[string]*RuleDelta)
+	for _, rule := range comparison.ByRule {
+		rules[rule.Rule] = rule
+	}
+	if len(rules) != 3 {
+		t.Fatalf("Expected 3 rules, got %d", len(rules))
+	}
+	if review := rules["review_comment"]; review.BaseRate != 100 || review.HeadRate != 50 {
+		t.Errorf("review_comment = %+v, want 100%% → 50%%", review)
+	}
+
+	// bob was already non-compliant, carol stays compliant
+	var names []string
+	for _, author := range comparison.NewlyNonCompliantAuthors {
+		names = append(names, author.Author)
+	}
+	if strings.Join(names, ",") != "alice,dave" {
+		t.Errorf("NewlyNonCompliantAuthors = %v, want [alice dave]", names)
+	}
+}
+
+func TestCompare_DifferentModes(t *testing.T) {
+	_, err := Compare(&ScanSnapshot{ScanMode: "bug"}, &ScanSnapshot{ScanMode: "pr_rules"})
+	if err == nil {
+		t.Error("Expected error when comparing different scan modes")
+	}
+}
+
+func TestCompare_DifferentBugTypes(t *testing.T) {
+	_, err := Compare(&ScanSnapshot{ScanMode: "bug", BugType: "bug"}, &ScanSnapshot{ScanMode: "bug", BugType: "bug_review"})
+	if err == nil {
+		t.Error("Expected error when comparing different bug types")
+	}
+}
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	snapshot := &ScanSnapshot{
+		Platform:        "github",
+		ScanMode:        "bug",
+		BugType:         "bug",
+		Repositories:    []string{"org/api"},
+		StartDate:       time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:         time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC),
+		TotalPRsCrawled: 1,
+		BugResults:      []*analyzer.BugResult{newBugResult("org/api", "alice", true)},
+	}
+
+	filename := filepath.Join(t.TempDir(), "snapshot.json")
+	if err := NewReporter().ExportJSON(filename, snapshot); err != nil {
+		t.Fatalf("ExportJSON failed: %v", err)
+	}
+
+	loaded, err := LoadSnapshot(filename)
+	if err != nil {
+		t.Fatalf("LoadSnapshot failed: %v", err)
+	}
+
+	if loaded.PeriodLabel() != "2025-01-01 → 2025-01-14" {
+		t.Errorf("PeriodLabel = %q", loaded.PeriodLabel())
+	}
+	if len(loaded.BugResults) != 1 || loaded.BugResults[0].PR.Repository != "org/api" || !loaded.BugResults[0].IsBugRelated {
+		t.Errorf("BugResults not restored: %+v", loaded.BugResults)
+	}
+}
+
+func TestExportComparison(t *testing.T) {
+	comparison := &Comparison{
+		ScanMode:   "bug",
+		BasePeriod: "2025-01-01 → 2025-01-14",
+		HeadPeriod: "2025-01-15 → 2025-01-28",
+		Overall:    &RatioDelta{BaseTotal: 4, BaseBugs: 1, BaseRatio: 25, HeadTotal: 4, HeadBugs: 2, HeadRatio: 50, Delta: 25},
+		ByRepository: []*RatioDelta{
+			{Key: "org/web", BaseTotal: 2, HeadTotal: 3, HeadBugs: 2, HeadRatio: 66.67, Delta: 66.67},
+		},
+	}
+
+	dir := t.TempDir()
+	reporter := NewReporter()
+
+	mdFile := filepath.Join(dir, "compare.md")
+	if err := reporter.ExportComparisonMarkdown(mdFile, comparison); err != nil {
+		t.Fatalf("ExportComparisonMarkdown failed: %v", err)
+	}
+	md, _ := os.ReadFile(mdFile)
+	if !strings.Contains(string(md), "| org/web | 0.00% (0/2) | 66.67% (2/3) | +66.67 |") {
+		t.Errorf("Markdown missing repository row:\n%s", md)
+	}
+
+	csvFile := filepath.Join(dir, "compare.csv")
+	if err := reporter.ExportComparisonCSV(csvFile, comparison); err != nil {
+		t.Fatalf("ExportComparisonCSV failed: %v", err)
+	}
+	csv, _ := os.ReadFile(csvFile)
+	lines := strings.Split(strings.TrimSpace(string(csv)), "\n")
+	if len(lines) != 3 { // Header + overall + 1 repository
+		t.Errorf("Expected 3 lines in CSV, got %d", len(lines))
+	}
+	if lines[2] != "repository,\"org/web\",0,2,0.00,2,3,66.67,66.67" {
+		t.Errorf("Unexpected repository row: %s", lines[2])
+	}
+}
@@ -0,0 +1,238 @@
+package report
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/bug-crawler/pkg/analyzer"
+)
+
+// maxEscapedRows is the number of escaped bug PRs printed; the CSV contains all of them
+const maxEscapedRows = 20
+
+// EscapeStats contains the bug PRs of a group by release stage of their target branch
+type EscapeStats struct {
+	Repository string         `json:"repository,omitempty"` // Empty when aggregated over repositories
+	Month      string         `json:"month,omitempty"`      // YYYY-MM of the merge (creation if not merged), empty when aggregated over months
+	BugPRs     int            `json:"bug_prs"`
+	ByStage    map[string]int `json:"by_stage"`
+	Escaped    int            `json:"escaped"`     // Bug PRs targeting a release or main branch
+	EscapeRate float64        `json:"escape_rate"` // Escaped / BugPRs in percent
+}
+
+// EscapedPR is a bug PR fixed after the release
+type EscapedPR struct {
+	Result *analyzer.BugResult
+	Stage  string // StageRelease or StageMain
+	Month  string
+}
+
+// EscapeReport contains the escape rate overall, per repository, per month and per repository and month
+type EscapeReport struct {
+	Overall           *EscapeStats   `json:"overall"`
+	ByRepository      []*EscapeStats `json:"by_repository"`
+	ByMonth           []*EscapeStats `json:"by_month"`
+	ByRepositoryMonth []*EscapeStats `json:"by_repository_month"`
+	EscapedPRs        []*EscapedPR   `json:"-"`
+}
+
+// BuildEscapeReport classifies the bug-related PRs with a known target branch by release stage.
+// PRs without a target branch (not provided by the platform) are left out.
+func BuildEscapeReport(results []*analyzer.BugResult, ea *analyzer.EscapeAnalyzer) *EscapeReport {
+	escape := &EscapeReport{
+		Overall: &EscapeStats{ByStage: make(map[string]int)},
+	}
+	byRepository := make(map[string]*EscapeStats)
+	byMonth := make(map[string]*EscapeStats)
+	byRepositoryMonth := make(map[string]*EscapeStats)
+	getStats := func(groups map[string]*EscapeStats, repository, month string) *EscapeStats {
+		key := repository + " " + month
+		stats, exists := groups[key]
+		if !exists {
+			stats = &EscapeStats{Repository: repository, Month: month, ByStage: make(map[string]int)}
+			groups[key] = stats
+		}
+		return stats
+	}
+
+	for _, result := range results {
+		if !result.IsBugRelated || result.PR.TargetBranch == "" {
+			continue
+		}
+
+		stage := ea.Stage(result.PR.TargetBranch)
+		escaped := analyzer.IsEscaped(stage)
+		month := fixMonth(result)
+		for _, stats := range []*EscapeStats{
+			escape.Overall,
+			getStats(byRepository, result.PR.Repository, ""),
+			getStats(byMonth, "", month),
+			getStats(byRepositoryMonth, result.PR.Repository, month),
+		} {
+			stats.BugPRs++
+			stats.ByStage[stage]++
+			if escaped {
+				stats.Escaped++
+			}
+		}
+
+		if escaped {
+			escape.EscapedPRs = append(escape.EscapedPRs, &EscapedPR{Result: result, Stage: stage, Month: month})
+		}
+	}
+
+	escape.Overall.EscapeRate = percentage(escape.Overall.Escaped, escape.Overall.BugPRs)
+	escape.ByRepository = sortedEscapeStats(byRepository)
+	escape.ByMonth = sortedEscapeStats(byMonth)
+	escape.ByRepositoryMonth = sortedEscapeStats(byRepositoryMonth)
+
+	sort.SliceStable(escape.EscapedPRs, func(i, j int) bool {
+		a, b := escape.EscapedPRs[i], escape.EscapedPRs[j]
+		if a.Result.PR.Repository != b.Result.PR.Repository {
+			return a.Result.PR.Repository < b.Result.PR.Repository
+		}
+		if a.Month != b.Month {
+			return a.Month < b.Month
+		}
+		return a.Result.PR.Number < b.Result.PR.Number
+	})
+	return escape
+}
+
+// fixMonth returns the month a bug was fixed: the merge month, or the creation month if not merged
+func fixMonth(result *analyzer.BugResult) string {
+	if result.PR.MergedAt != nil {
+		return result.PR.MergedAt.Format("2006-01")
+	}
+	return result.PR.CreatedAt.Format("2006-01")
+}
+
+// sortedEscapeStats computes the escape rates and sorts groups by repository, then month
+func sortedEscapeStats(groups map[string]*EscapeStats) []*EscapeStats {
+	stats := make([]*EscapeStats, 0, len(groups))
+	for _, s := range groups {
+		s.EscapeRate = percentage(s.Escaped, s.BugPRs)
+		stats = append(stats, s)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Repository != stats[j].Repository {
+			return stats[i].Repository < stats[j].Repository
+		}
+		return stats[i].Month < stats[j].Month
+	})
+	return stats
+}
+
+// PrintEscapeReport prints the escape rates and the escaped bug PRs
+func (r *Reporter) PrintEscapeReport(escape *EscapeReport) {
+	if escape.Overall.BugPRs == 0 {
+		return
+	}
+
+	separator := "=========================================================================================================================="
+	fmt.Println("\nTỶ LỆ BUG SAU RELEASE (ESCAPE RATE) THEO TARGET BRANCH:")
+	fmt.Println(separator)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "REPOSITORY\tTHÁNG\tPR BUG\tDEVELOP\tRELEASE\tMAIN\tKHÁC\tESCAPE RATE")
+	printGroup := func(stats []*EscapeStats) {
+		for _, s := range stats {
+			repository, month := s.Repository, s.Month
+			if repository == "" {
+				repository = "all"
+			}
+			if month == "" {
+				month = "all"
+			}
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t%d\t%d\t%.1f%%\n",
+				repository, month, s.BugPRs,
+				s.ByStage[analyzer.StageDevelop], s.ByStage[analyzer.StageRelease], s.ByStage[analyzer.StageMain], s.ByStage[analyzer.StageOther],
+				s.EscapeRate)
+		}
+	}
+	printGroup([]*EscapeStats{escape.Overall})
+	printGroup(escape.ByMonth)
+	printGroup(escape.ByRepository)
+	printGroup(escape.ByRepositoryMonth)
+	_ = w.Flush()
+
+	if len(escape.EscapedPRs) > 0 {
+		fmt.Println("\nBug được fix sau release:")
+		w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		_, _ = fmt.Fprintln(w, "REPOSITORY\tTHÁNG\tPR#\tTARGET\tTITLE")
+		for i, escaped := range escape.EscapedPRs {
+			if i == maxEscapedRows {
+				_, _ = fmt.Fprintf(w, "... (+%d)\t\t\t\t\n", len(escape.EscapedPRs)-maxEscapedRows)
+				break
+			}
+			pr := escaped.Result.PR
+			title := pr.Title
+			if len(title) > 40 {
+				title = title[:37] + "..."
+			}
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\n", pr.Repository, escaped.Month, pr.Number, pr.TargetBranch, title)
+		}
+		_ = w.Flush()
+	}
+
+	fmt.Println(separator)
+}
+
+// ExportEscapeCSV exports the escape rates per group to CSV
+func (r *Reporter) ExportEscapeCSV(filename string, escape *EscapeReport) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = file.Close() }()
+
+	_, _ = fmt.Fprintln(file, "group,repository,month,bug_prs,develop,release,main,other,escaped,escape_rate")
+
+	writeGroup := func(group string, stats []*EscapeStats) {
+		for _, s := range stats {
+			_, _ = fmt.Fprintf(file, "%s,\"%s\",%s,%d,%d,%d,%d,%d,%d,%.2f\n",
+				group, s.Repository, s.Month, s.BugPRs,
+				s.ByStage[analyzer.StageDevelop], s.ByStage[analyzer.StageRelease], s.ByStage[analyzer.StageMain], s.ByStage[analyzer.StageOther],
+				s.Escaped, s.EscapeRate)
+		}
+	}
+
+	writeGroup("overall", []*EscapeStats{escape.Overall})
+	writeGroup("month", escape.ByMonth)
+	writeGroup("repository", escape.ByRepository)
+	writeGroup("repository_month", escape.ByRepositoryMonth)
+
+	fmt.Printf("\nEscape rate đã được export vào: %s\n", filename)
+	return nil
+}
+
+// ExportEscapedPRsCSV exports the bug PRs fixed after the release to CSV
+func (r *Reporter) ExportEscapedPRsCSV(filename string, escape *EscapeReport) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = file.Close() }()
+
+	_, _ = fmt.Fprintln(file, "repository,month,pr_number,title,author,target_branch,stage,detection_type,url")
+
+	for _, escaped := range escape.EscapedPRs {
+		pr := escaped.Result.PR
+		_, _ = fmt.Fprintf(file, "%s,%s,%d,\"%s\",%s,%s,%s,%s,%s\n",
+			pr.Repository,
+			escaped.Month,
+			pr.Number,
+			pr.Title,
+			pr.Author,
+			pr.TargetBranch,
+			escaped.Stage,
+			escaped.Result.DetectionType,
+			pr.HTMLURL,
+		)
+	}
+
+	fmt.Printf("\nBug sau release đã được export vào: %s\n", filename)
+	return nil
+}
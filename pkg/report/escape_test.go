@@ -0,0 +1,52 @@
+package report
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bug-crawler/pkg/analyzer"
+	"github.com/bug-crawler/pkg/platform"
+)
+
+func TestBuildEscapeReport(t *testing.T) {
+	march := time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)
+	april := time.Date(2024, 4, 2, 0, 0, 0, 0, time.UTC)
+
+	results := []*analyzer.BugResult{
+		{PR: &platform.PullRequestData{Repository: "org/a", Number: 1, TargetBranch: "develop", CreatedAt: march, MergedAt: &march}, IsBugRelated: true},
+		{PR: &platform.PullRequestData{Repository: "org/a", Number: 2, TargetBranch: "main", CreatedAt: march, MergedAt: &march}, IsBugRelated: true},
+		{PR: &platform.PullRequestData{Repository: "org/a", Number: 3, TargetBranch: "release/1.0", CreatedAt: march, MergedAt: &april}, IsBugRelated: true},
+		{PR: &platform.PullRequestData{Repository: "org/b", Number: 1, TargetBranch: "feature/x", CreatedAt: april}, IsBugRelated: true},
+		{PR: &platform.PullRequestData{Repository: "org/b", Number: 2, TargetBranch: "main", CreatedAt: april}},
+		{PR: &platform.PullRequestData{Repository: "org/b", Number: 3, CreatedAt: april}, IsBugRelated: true},
+	}
+
+	ea, err := analyzer.NewEscapeAnalyzer(nil)
+	if err != nil {
+		t.Fatalf("NewEscapeAnalyzer() error = %v", err)
+	}
+	got := BuildEscapeReport(results, ea)
+
+	if got.Overall.BugPRs != 4 || got.Overall.Escaped != 2 || got.Overall.EscapeRate != 50 {
+		t.Errorf("Overall = %+v, want 4 bug PRs, 2 escaped, 50%%", got.Overall)
+	}
+	if got.Overall.ByStage[analyzer.StageOther] != 1 {
+		t.Errorf("Overall.ByStage = %v, want 1 other", got.Overall.ByStage)
+	}
+
+	if len(got.ByRepository) != 2 || got.ByRepository[0].Repository != "org/a" || got.ByRepository[0].Escaped != 2 {
+		t.Errorf("ByRepository[0] = %+v, want org/a with 2 escaped", got.ByRepository[0])
+	}
+
+	if len(got.ByMonth) != 2 || got.ByMonth[0].Month != "2024-03" || got.ByMonth[0].BugPRs != 2 || got.ByMonth[1].Escaped != 1 {
+		t.Errorf("ByMonth = %+v, want 2024-03 with 2 bug PRs and 2024-04 with 1 escaped", got.ByMonth)
+	}
+
+	if len(got.ByRepositoryMonth) != 3 {
+		t.Errorf("ByRepositoryMonth has %d groups, want 3", len(got.ByRepositoryMonth))
+	}
+
+	if len(got.EscapedPRs) != 2 || got.EscapedPRs[0].Result.PR.Number != 2 || got.EscapedPRs[1].Stage != analyzer.StageRelease {
+		t.Errorf("EscapedPRs = %+v, want #2 (main) then #3 (release)", got.EscapedPRs)
+	}
+}
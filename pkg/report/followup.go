@@ -0,0 +1,211 @@
+package report
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/bug-crawler/pkg/analyzer"
+)
+
+// maxFollowUpLinks is the number of caused-by links printed; the CSV contains all of them
+const maxFollowUpLinks = 20
+
+// FollowUpStats contains the merged PRs of a group that were later reverted or fixed
+type FollowUpStats struct {
+	Key        string  `json:"key"`
+	MergedPRs  int     `json:"merged_prs"`
+	Reverted   int     `json:"reverted"`    // Merged PRs reverted within the window
+	Fixed      int     `json:"fixed"`       // Merged PRs referenced by a later bug fix within the window
+	FollowedUp int     `json:"followed_up"` // Merged PRs reverted or fixed, each counted once
+	Rate       float64 `json:"rate"`        // FollowedUp / MergedPRs in percent
+}
+
+// FollowUpReport contains the follow-up rates overall, per repository and per author of the original PR
+type FollowUpReport struct {
+	Days         int                      `json:"days"`
+	Links        []*analyzer.FollowUpLink `json:"-"`
+	Overall      *FollowUpStats           `json:"overall"`
+	ByRepository []*FollowUpStats         `json:"by_repository"`
+	ByAuthor     []*FollowUpStats         `json:"by_author"`
+}
+
+// followUpCounter collects the merged and followed-up PRs of a group
+type followUpCounter struct {
+	merged   int
+	reverted map[string]bool // Keyed by "repository#number"
+	fixed    map[string]bool
+}
+
+// BuildFollowUpReport computes the rate of merged PRs later reverted or fixed within days
+func BuildFollowUpReport(results []*analyzer.BugResult, links []*analyzer.FollowUpLink, days int) *FollowUpReport {
+	overall := &followUpCounter{reverted: make(map[string]bool), fixed: make(map[string]bool)}
+	byRepository := make(map[string]*followUpCounter)
+	byAuthor := make(map[string]*followUpCounter)
+	getCounter := func(groups map[string]*followUpCounter, key string) *followUpCounter {
+		counter, exists := groups[key]
+		if !exists {
+			counter = &followUpCounter{reverted: make(map[string]bool), fixed: make(map[string]bool)}
+			groups[key] = counter
+		}
+		return counter
+	}
+
+	for _, result := range results {
+		if result.PR.MergedAt == nil {
+			continue
+		}
+		overall.merged++
+		getCounter(byRepository, result.PR.Repository).merged++
+		getCounter(byAuthor, result.PR.Author).merged++
+	}
+
+	for _, link := range links {
+		original := link.CausedBy
+		// A PR reverted or fixed several times counts once
+		key := fmt.Sprintf("%s#%d", original.Repository, original.Number)
+		for _, counter := range []*followUpCounter{overall, getCounter(byRepository, original.Repository), getCounter(byAuthor, original.Author)} {
+			if link.Kind == analyzer.FollowUpRevert {
+				counter.reverted[key] = true
+			} else {
+				counter.fixed[key] = true
+			}
+		}
+	}
+
+	return &FollowUpReport{
+		Days:         days,
+		Links:        links,
+		Overall:      overall.stats("all"),
+		ByRepository: sortedFollowUpStats(byRepository),
+		ByAuthor:     sortedFollowUpStats(byAuthor),
+	}
+}
+
+func (c *followUpCounter) stats(key string) *FollowUpStats {
+	followedUp := len(c.reverted)
+	for pr := range c.fixed {
+		if !c.reverted[pr] {
+			followedUp++
+		}
+	}
+	return &FollowUpStats{
+		Key:        key,
+		MergedPRs:  c.merged,
+		Reverted:   len(c.reverted),
+		Fixed:      len(c.fixed),
+		FollowedUp: followedUp,
+		Rate:       percentage(followedUp, c.merged),
+	}
+}
+
+// sortedFollowUpStats sorts groups by followed-up PRs, then rate, then key
+func sortedFollowUpStats(groups map[string]*followUpCounter) []*FollowUpStats {
+	stats := make([]*FollowUpStats, 0, len(groups))
+	for key, counter := range groups {
+		stats = append(stats, counter.stats(key))
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].FollowedUp != stats[j].FollowedUp {
+			return stats[i].FollowedUp > stats[j].FollowedUp
+		}
+		if stats[i].Rate != stats[j].Rate {
+			return stats[i].Rate > stats[j].Rate
+		}
+		return stats[i].Key < stats[j].Key
+	})
+	return stats
+}
+
+// PrintFollowUpReport prints the follow-up rates and the caused-by links
+func (r *Reporter) PrintFollowUpReport(followUp *FollowUpReport) {
+	if followUp.Overall.MergedPRs == 0 {
+		return
+	}
+
+	separator := "=========================================================================================================================="
+	fmt.Printf("\nPR BỊ REVERT HOẶC PHẢI FIX SAU KHI MERGE (TRONG %d NGÀY):\n", followUp.Days)
+	fmt.Println(separator)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "NHÓM\tPR MERGED\tBỊ REVERT\tPHẢI FIX\tTỶ LỆ")
+	printGroup := func(label string, stats []*FollowUpStats) {
+		for _, s := range stats {
+			_, _ = fmt.Fprintf(w, "%s%s\t%d\t%d\t%d\t%.1f%%\n", label, s.Key, s.MergedPRs, s.Reverted, s.Fixed, s.Rate)
+		}
+	}
+	printGroup("", []*FollowUpStats{followUp.Overall})
+	printGroup("repo: ", followUp.ByRepository)
+	printGroup("author: ", followUp.ByAuthor)
+	_ = w.Flush()
+
+	if len(followUp.Links) > 0 {
+		fmt.Println("\nLiên kết caused-by:")
+		w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		_, _ = fmt.Fprintln(w, "REPO\tPR\tLOẠI\tGÂY RA BỞI\tAUTHOR GỐC\tSAU (NGÀY)")
+		for i, link := range followUp.Links {
+			if i == maxFollowUpLinks {
+				_, _ = fmt.Fprintf(w, "... (+%d)\t\t\t\t\t\n", len(followUp.Links)-maxFollowUpLinks)
+				break
+			}
+			_, _ = fmt.Fprintf(w, "%s\t#%d\t%s\t#%d\t%s\t%.1f\n",
+				link.PR.Repository, link.PR.Number, link.Kind, link.CausedBy.Number, link.CausedBy.Author, link.After.Hours()/24)
+		}
+		_ = w.Flush()
+	}
+
+	fmt.Println(separator)
+}
+
+// ExportFollowUpCSV exports the follow-up rates per group to CSV
+func (r *Reporter) ExportFollowUpCSV(filename string, followUp *FollowUpReport) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = file.Close() }()
+
+	_, _ = fmt.Fprintln(file, "group,key,merged_prs,reverted,fixed,followed_up,rate")
+
+	writeGroup := func(group string, stats []*FollowUpStats) {
+		for _, s := range stats {
+			_, _ = fmt.Fprintf(file, "%s,\"%s\",%d,%d,%d,%d,%.2f\n", group, s.Key, s.MergedPRs, s.Reverted, s.Fixed, s.FollowedUp, s.Rate)
+		}
+	}
+
+	writeGroup("overall", []*FollowUpStats{followUp.Overall})
+	writeGroup("repository", followUp.ByRepository)
+	writeGroup("author", followUp.ByAuthor)
+
+	fmt.Printf("\nTỷ lệ revert/fix đã được export vào: %s\n", filename)
+	return nil
+}
+
+// ExportFollowUpLinksCSV exports the caused-by links to CSV
+func (r *Reporter) ExportFollowUpLinksCSV(filename string, followUp *FollowUpReport) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = file.Close() }()
+
+	_, _ = fmt.Fprintln(file, "repository,pr_number,pr_title,kind,caused_by_number,caused_by_title,caused_by_author,days_after_merge,url")
+
+	for _, link := range followUp.Links {
+		_, _ = fmt.Fprintf(file, "%s,%d,\"%s\",%s,%d,\"%s\",%s,%.2f,%s\n",
+			link.PR.Repository,
+			link.PR.Number,
+			link.PR.Title,
+			link.Kind,
+			link.CausedBy.Number,
+			link.CausedBy.Title,
+			link.CausedBy.Author,
+			link.After.Hours()/24,
+			link.PR.HTMLURL,
+		)
+	}
+
+	fmt.Printf("\nLiên kết caused-by đã được export vào: %s\n", filename)
+	return nil
+}
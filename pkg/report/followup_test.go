@@ -0,0 +1,41 @@
+package report
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bug-crawler/pkg/analyzer"
+	"github.com/bug-crawler/pkg/platform"
+)
+
+func TestBuildFollowUpReport(t *testing.T) {
+	merged := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	a1 := &platform.PullRequestData{Repository: "org/a", Number: 1, Author: "alice", MergedAt: &merged}
+	a2 := &platform.PullRequestData{Repository: "org/a", Number: 2, Author: "bob", MergedAt: &merged}
+	b1 := &platform.PullRequestData{Repository: "org/b", Number: 1, Author: "alice", MergedAt: &merged}
+	open := &platform.PullRequestData{Repository: "org/b", Number: 2, Author: "alice"}
+
+	results := []*analyzer.BugResult{{PR: a1}, {PR: a2}, {PR: b1}, {PR: open}}
+	links := []*analyzer.FollowUpLink{
+		{PR: &platform.PullRequestData{Number: 5}, CausedBy: a1, Kind: analyzer.FollowUpRevert},
+		{PR: &platform.PullRequestData{Number: 6}, CausedBy: a1, Kind: analyzer.FollowUpFix},
+		{PR: &platform.PullRequestData{Number: 7}, CausedBy: b1, Kind: analyzer.FollowUpFix},
+	}
+
+	got := BuildFollowUpReport(results, links, 14)
+
+	if got.Overall.MergedPRs != 3 || got.Overall.Reverted != 1 || got.Overall.Fixed != 2 || got.Overall.FollowedUp != 2 {
+		t.Errorf("Overall = %+v, want 3 merged, 1 reverted, 2 fixed, 2 followed up", got.Overall)
+	}
+
+	if len(got.ByAuthor) != 2 || got.ByAuthor[0].Key != "alice" || got.ByAuthor[0].FollowedUp != 2 || got.ByAuthor[0].Rate != 100 {
+		t.Errorf("ByAuthor[0] = %+v, want alice with 2/2 followed up", got.ByAuthor[0])
+	}
+	if got.ByAuthor[1].Key != "bob" || got.ByAuthor[1].FollowedUp != 0 {
+		t.Errorf("ByAuthor[1] = %+v, want bob without follow-up", got.ByAuthor[1])
+	}
+
+	if len(got.ByRepository) != 2 || got.ByRepository[0].Key != "org/b" || got.ByRepository[0].Rate != 100 {
+		t.Errorf("ByRepository[0] = %+v, want org/b at 100%%", got.ByRepository[0])
+	}
+}
@@ -0,0 +1,140 @@
+package report
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/bug-crawler/pkg/analyzer"
+)
+
+// maxHotspotRows is the number of hotspots printed per group; the CSV contains all of them
+const maxHotspotRows = 15
+
+// HotspotStats contains the bug PRs touching a directory or file extension
+type HotspotStats struct {
+	Key            string `json:"key"`
+	BugPRs         int    `json:"bug_prs"`
+	BugReviewCount int    `json:"bug_review_count"` // Sum of bug_review counts of these PRs
+	FileChanges    int    `json:"file_changes"`     // Changed files of these PRs under the key
+}
+
+// HotspotReport contains bug hotspots by directory prefix and by file extension
+type HotspotReport struct {
+	PRsWithFiles int             `json:"prs_with_files"`
+	ByDirectory  []*HotspotStats `json:"by_directory"`
+	ByExtension  []*HotspotStats `json:"by_extension"`
+}
+
+// BuildHotspotReport aggregates the changed files of bug-related PRs by directory and extension.
+// A PR counts once per hotspot, however many of its files fall under it.
+func BuildHotspotReport(results []*analyzer.BugResult, ha *analyzer.HotspotAnalyzer) *HotspotReport {
+	hotspots := &HotspotReport{}
+	byDirectory := make(map[string]*HotspotStats)
+	byExtension := make(map[string]*HotspotStats)
+
+	add := func(groups map[string]*HotspotStats, keys map[string]int, result *analyzer.BugResult) {
+		for key, files := range keys {
+			stats, exists := groups[key]
+			if !exists {
+				stats = &HotspotStats{Key: key}
+				groups[key] = stats
+			}
+			stats.BugPRs++
+			stats.BugReviewCount += result.BugCount
+			stats.FileChanges += files
+		}
+	}
+
+	for _, result := range results {
+		if !result.IsBugRelated || result.PR.Files == nil {
+			continue
+		}
+		hotspots.PRsWithFiles++
+
+		directories := make(map[string]int)
+		extensions := make(map[string]int)
+		for _, file := range ha.FilterFiles(result.PR.Files) {
+			directories[ha.Directory(file)]++
+			extensions[ha.Extension(file)]++
+		}
+		add(byDirectory, directories, result)
+		add(byExtension, extensions, result)
+	}
+
+	hotspots.ByDirectory = sortedHotspots(byDirectory)
+	hotspots.ByExtension = sortedHotspots(byExtension)
+	return hotspots
+}
+
+// sortedHotspots sorts hotspots by bug PRs, then bug_review count, then key
+func sortedHotspots(groups map[string]*HotspotStats) []*HotspotStats {
+	hotspots := make([]*HotspotStats, 0, len(groups))
+	for _, stats := range groups {
+		hotspots = append(hotspots, stats)
+	}
+	sort.Slice(hotspots, func(i, j int) bool {
+		if hotspots[i].BugPRs != hotspots[j].BugPRs {
+			return hotspots[i].BugPRs > hotspots[j].BugPRs
+		}
+		if hotspots[i].BugReviewCount != hotspots[j].BugReviewCount {
+			return hotspots[i].BugReviewCount > hotspots[j].BugReviewCount
+		}
+		return hotspots[i].Key < hotspots[j].Key
+	})
+	return hotspots
+}
+
+// PrintHotspotReport prints the top bug hotspots by directory and by extension
+func (r *Reporter) PrintHotspotReport(hotspots *HotspotReport) {
+	if hotspots.PRsWithFiles == 0 {
+		return
+	}
+
+	separator := "=========================================================================================================================="
+	fmt.Printf("\nBUG HOTSPOTS (%d PR bug có danh sách file):\n", hotspots.PRsWithFiles)
+	fmt.Println(separator)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	printGroup := func(header string, stats []*HotspotStats) {
+		_, _ = fmt.Fprintf(w, "%s\tPR BUG\tBUG_REVIEW\tFILE THAY ĐỔI\n", header)
+		for i, s := range stats {
+			if i == maxHotspotRows {
+				_, _ = fmt.Fprintf(w, "... (+%d)\t\t\t\n", len(stats)-maxHotspotRows)
+				break
+			}
+			_, _ = fmt.Fprintf(w, "%s\t%d\t%d\t%d\n", s.Key, s.BugPRs, s.BugReviewCount, s.FileChanges)
+		}
+	}
+
+	printGroup("THƯ MỤC", hotspots.ByDirectory)
+	_, _ = fmt.Fprintln(w, "\t\t\t")
+	printGroup("EXTENSION", hotspots.ByExtension)
+
+	_ = w.Flush()
+	fmt.Println(separator)
+}
+
+// ExportHotspotCSV exports all bug hotspots to CSV
+func (r *Reporter) ExportHotspotCSV(filename string, hotspots *HotspotReport) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = file.Close() }()
+
+	_, _ = fmt.Fprintln(file, "group,key,bug_prs,bug_review_count,file_changes")
+
+	writeGroup := func(group string, stats []*HotspotStats) {
+		for _, s := range stats {
+			_, _ = fmt.Fprintf(file, "%s,\"%s\",%d,%d,%d\n", group, s.Key, s.BugPRs, s.BugReviewCount, s.FileChanges)
+		}
+	}
+
+	writeGroup("directory", hotspots.ByDirectory)
+	writeGroup("extension", hotspots.ByExtension)
+
+	fmt.Printf("\nBug hotspots đã được export vào: %s\n", filename)
+	return nil
+}
@@ -0,0 +1,62 @@
+package report
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bug-crawler/pkg/analyzer"
+	"github.com/bug-crawler/pkg/platform"
+)
+
+func TestBuildHotspotReport(t *testing.T) {
+	ha, err := analyzer.NewHotspotAnalyzer(&analyzer.HotspotConfig{Depth: 1, Exclude: []string{"*.md"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results := []*analyzer.BugResult{
+		{
+			PR:           &platform.PullRequestData{Files: []string{"api/a.go", "api/b.go", "web/app.ts", "README.md"}},
+			IsBugRelated: true,
+			BugCount:     3,
+		},
+		{
+			PR:           &platform.PullRequestData{Files: []string{"api/c.go"}},
+			IsBugRelated: true,
+			BugCount:     1,
+		},
+		{PR: &platform.PullRequestData{Files: []string{"web/x.ts"}}},                // Not a bug
+		{PR: &platform.PullRequestData{Number: 4}, IsBugRelated: true, BugCount: 9}, // Files not fetched
+	}
+
+	hotspots := BuildHotspotReport(results, ha)
+
+	if hotspots.PRsWithFiles != 2 {
+		t.Errorf("PRsWithFiles = %d, want 2", hotspots.PRsWithFiles)
+	}
+	if len(hotspots.ByDirectory) != 2 {
+		t.Fatalf("ByDirectory = %d hotspots, want 2", len(hotspots.ByDirectory))
+	}
+	api := hotspots.ByDirectory[0]
+	if api.Key != "api/" || api.BugPRs != 2 || api.BugReviewCount != 4 || api.FileChanges != 3 {
+		t.Errorf("api hotspot = %+v", api)
+	}
+	if len(hotspots.ByExtension) != 2 || hotspots.ByExtension[0].Key != ".go" || hotspots.ByExtension[1].Key != ".ts" {
+		t.Errorf("ByExtension = %+v", hotspots.ByExtension)
+	}
+
+	filename := filepath.Join(t.TempDir(), "hotspots.csv")
+	if err := NewReporter().ExportHotspotCSV(filename, hotspots); err != nil {
+		t.Fatalf("ExportHotspotCSV() error = %v", err)
+	}
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 5 || lines[1] != `directory,"api/",2,4,3` {
+		t.Errorf("CSV = %q", lines)
+	}
+}
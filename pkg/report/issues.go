@@ -0,0 +1,212 @@
+package report
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/bug-crawler/pkg/analyzer"
+	"github.com/bug-crawler/pkg/platform"
+)
+
+// unassigned is the assignee key of bug issues without an assignee
+const unassigned = "(none)"
+
+// BugIssueStats contains the bug issues of a group and their resolution time in hours
+type BugIssueStats struct {
+	Key             string      `json:"key"`
+	Issues          int         `json:"issues"`
+	Resolved        int         `json:"resolved"`
+	ResolutionHours Percentiles `json:"resolution_hours"`
+}
+
+// ProjectBugIssues contains the bug issues of a project next to its bug-related PRs
+type ProjectBugIssues struct {
+	Project    string           `json:"project"`
+	BugPRs     int              `json:"bug_prs"`
+	Overall    *BugIssueStats   `json:"overall"`
+	ByStatus   []*BugIssueStats `json:"by_status"`
+	ByAssignee []*BugIssueStats `json:"by_assignee"`
+}
+
+// bugIssueSamples collects the raw values of a group
+type bugIssueSamples struct {
+	issues     int
+	resolved   int
+	resolution []float64
+}
+
+func (s *bugIssueSamples) add(issue *platform.IssueData) {
+	s.issues++
+	if issue.ResolvedAt != nil && issue.CreatedAt != nil {
+		s.resolved++
+		s.resolution = append(s.resolution, issue.ResolvedAt.Sub(*issue.CreatedAt).Hours())
+	}
+}
+
+func (s *bugIssueSamples) stats(key string) *BugIssueStats {
+	return &BugIssueStats{
+		Key:             key,
+		Issues:          s.issues,
+		Resolved:        s.resolved,
+		ResolutionHours: computePercentiles(s.resolution),
+	}
+}
+
+// BuildBugIssueReport groups bug issues by project, status and assignee, and counts the bug-related PRs
+// of the project repositories ("PROJECT/repo"). Every scanned project is listed, even without bug issues.
+func BuildBugIssueReport(projects []string, issues []*platform.IssueData, results []*analyzer.BugResult) []*ProjectBugIssues {
+	type projectSamples struct {
+		bugPRs     int
+		overall    *bugIssueSamples
+		byStatus   map[string]*bugIssueSamples
+		byAssignee map[string]*bugIssueSamples
+	}
+	groups := make(map[string]*projectSamples)
+	getGroup := func(project string) *projectSamples {
+		group, exists := groups[project]
+		if !exists {
+			group = &projectSamples{
+				overall:    &bugIssueSamples{},
+				byStatus:   make(map[string]*bugIssueSamples),
+				byAssignee: make(map[string]*bugIssueSamples),
+			}
+			groups[project] = group
+		}
+		return group
+	}
+	getSamples := func(samples map[string]*bugIssueSamples, key string) *bugIssueSamples {
+		s, exists := samples[key]
+		if !exists {
+			s = &bugIssueSamples{}
+			samples[key] = s
+		}
+		return s
+	}
+
+	for _, project := range projects {
+		getGroup(project)
+	}
+
+	for _, issue := range issues {
+		group := getGroup(issue.Project)
+		group.overall.add(issue)
+		getSamples(group.byStatus, issue.Status).add(issue)
+
+		assignee := issue.Assignee
+		if assignee == "" {
+			assignee = unassigned
+		}
+		getSamples(group.byAssignee, assignee).add(issue)
+	}
+
+	for _, result := range results {
+		if !result.IsBugRelated {
+			continue
+		}
+		project := strings.SplitN(result.PR.Repository, "/", 2)[0]
+		if group, exists := groups[project]; exists {
+			group.bugPRs++
+		}
+	}
+
+	report := make([]*ProjectBugIssues, 0, len(groups))
+	for project, group := range groups {
+		report = append(report, &ProjectBugIssues{
+			Project:    project,
+			BugPRs:     group.bugPRs,
+			Overall:    group.overall.stats("all"),
+			ByStatus:   sortedBugIssueStats(group.byStatus),
+			ByAssignee: sortedBugIssueStats(group.byAssignee),
+		})
+	}
+	sort.Slice(report, func(i, j int) bool {
+		return report[i].Project < report[j].Project
+	})
+	return report
+}
+
+// sortedBugIssueStats sorts groups by number of issues, then key
+func sortedBugIssueStats(samples map[string]*bugIssueSamples) []*BugIssueStats {
+	stats := make([]*BugIssueStats, 0, len(samples))
+	for key, s := range samples {
+		stats = append(stats, s.stats(key))
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Issues != stats[j].Issues {
+			return stats[i].Issues > stats[j].Issues
+		}
+		return stats[i].Key < stats[j].Key
+	})
+	return stats
+}
+
+// PrintBugIssueReport prints the bug issues of each project next to its bug-related PRs
+func (r *Reporter) PrintBugIssueReport(projects []*ProjectBugIssues) {
+	if len(projects) == 0 {
+		return
+	}
+
+	separator := "=========================================================================================================================="
+	fmt.Println("\nISSUE BUG THEO PROJECT (resolution time tính bằng giờ):")
+	fmt.Println(separator)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "PROJECT\tISSUE BUG\tĐÃ ĐÓNG\tP50\tP90\tPR BUG")
+	for _, p := range projects {
+		_, _ = fmt.Fprintf(w, "%s\t%d\t%d\t%.1f\t%.1f\t%d\n",
+			p.Project, p.Overall.Issues, p.Overall.Resolved, p.Overall.ResolutionHours.P50, p.Overall.ResolutionHours.P90, p.BugPRs)
+	}
+	_ = w.Flush()
+
+	for _, p := range projects {
+		if p.Overall.Issues == 0 {
+			continue
+		}
+		fmt.Printf("\n%s:\n", p.Project)
+		w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		printGroup := func(header string, stats []*BugIssueStats) {
+			_, _ = fmt.Fprintf(w, "  %s\tISSUE\tĐÃ ĐÓNG\tP50\tP90\n", header)
+			for _, s := range stats {
+				_, _ = fmt.Fprintf(w, "  %s\t%d\t%d\t%.1f\t%.1f\n", s.Key, s.Issues, s.Resolved, s.ResolutionHours.P50, s.ResolutionHours.P90)
+			}
+		}
+		printGroup("STATUS", p.ByStatus)
+		printGroup("ASSIGNEE", p.ByAssignee)
+		_ = w.Flush()
+	}
+
+	fmt.Println(separator)
+}
+
+// ExportBugIssueCSV exports the bug issues of each project, by status and by assignee, to CSV
+func (r *Reporter) ExportBugIssueCSV(filename string, projects []*ProjectBugIssues) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = file.Close() }()
+
+	_, _ = fmt.Fprintln(file, "project,group,key,issues,resolved,resolution_p50_hours,resolution_p75_hours,resolution_p90_hours,bug_prs")
+
+	writeRow := func(project, group string, s *BugIssueStats, bugPRs string) {
+		_, _ = fmt.Fprintf(file, "\"%s\",%s,\"%s\",%d,%d,%.2f,%.2f,%.2f,%s\n",
+			project, group, s.Key, s.Issues, s.Resolved,
+			s.ResolutionHours.P50, s.ResolutionHours.P75, s.ResolutionHours.P90, bugPRs)
+	}
+
+	for _, p := range projects {
+		writeRow(p.Project, "project", p.Overall, fmt.Sprint(p.BugPRs))
+		for _, s := range p.ByStatus {
+			writeRow(p.Project, "status", s, "")
+		}
+		for _, s := range p.ByAssignee {
+			writeRow(p.Project, "assignee", s, "")
+		}
+	}
+
+	fmt.Printf("\nIssue bug đã được export vào: %s\n", filename)
+	return nil
+}
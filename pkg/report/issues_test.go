@@ -0,0 +1,51 @@
+package report
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bug-crawler/pkg/analyzer"
+	"github.com/bug-crawler/pkg/platform"
+)
+
+func TestBuildBugIssueReport(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	after := func(h int) *time.Time {
+		resolved := created.Add(time.Duration(h) * time.Hour)
+		return &resolved
+	}
+	issues := []*platform.IssueData{
+		{Key: "PROJ-1", Project: "PROJ", Status: "完了", Assignee: "alice", CreatedAt: &created, ResolvedAt: after(10)},
+		{Key: "PROJ-2", Project: "PROJ", Status: "完了", Assignee: "alice", CreatedAt: &created, ResolvedAt: after(30)},
+		{Key: "PROJ-3", Project: "PROJ", Status: "処理中", CreatedAt: &created},
+	}
+	results := []*analyzer.BugResult{
+		{PR: &platform.PullRequestData{Repository: "PROJ/api"}, IsBugRelated: true},
+		{PR: &platform.PullRequestData{Repository: "PROJ/web"}, IsBugRelated: false},
+		{PR: &platform.PullRequestData{Repository: "OTHER/api"}, IsBugRelated: true},
+	}
+
+	got := BuildBugIssueReport([]string{"PROJ", "OTHER"}, issues, results)
+	if len(got) != 2 || got[0].Project != "OTHER" || got[1].Project != "PROJ" {
+		t.Fatalf("BuildBugIssueReport() projects = %+v, want OTHER, PROJ", got)
+	}
+
+	other := got[0]
+	if other.Overall.Issues != 0 || other.BugPRs != 1 || len(other.ByStatus) != 0 {
+		t.Errorf("OTHER = %+v, want 0 issues and 1 bug PR", other)
+	}
+
+	proj := got[1]
+	if proj.Overall.Issues != 3 || proj.Overall.Resolved != 2 || proj.BugPRs != 1 {
+		t.Errorf("PROJ overall = %+v, bug PRs %d, want 3 issues, 2 resolved, 1 bug PR", proj.Overall, proj.BugPRs)
+	}
+	if proj.Overall.ResolutionHours.P50 != 20 {
+		t.Errorf("PROJ resolution P50 = %v, want 20", proj.Overall.ResolutionHours.P50)
+	}
+	if proj.ByStatus[0].Key != "完了" || proj.ByStatus[0].Issues != 2 {
+		t.Errorf("PROJ by status = %+v, want 完了 first with 2 issues", proj.ByStatus[0])
+	}
+	if len(proj.ByAssignee) != 2 || proj.ByAssignee[0].Key != "alice" || proj.ByAssignee[1].Key != unassigned {
+		t.Errorf("PROJ by assignee = %+v, want alice then %s", proj.ByAssignee, unassigned)
+	}
+}
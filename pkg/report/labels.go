@@ -0,0 +1,111 @@
+package report
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/bug-crawler/pkg/analyzer"
+	"github.com/bug-crawler/pkg/platform"
+)
+
+// LabelStats contains the usage of a PR label across the scanned repositories
+type LabelStats struct {
+	Label        string   `json:"label"`
+	PRs          int      `json:"prs"`
+	Repositories []string `json:"repositories"`
+	BugPRs       int      `json:"bug_prs"` // PRs where the label is a bug label in the taxonomy of their repository
+}
+
+// BuildLabelStats counts the PRs of every label, sorted by PRs then label
+func BuildLabelStats(prs []*platform.PullRequestData, ba *analyzer.BugAnalyzer) []*LabelStats {
+	byLabel := make(map[string]*LabelStats)
+	repositories := make(map[string]map[string]bool)
+
+	for _, pr := range prs {
+		seen := make(map[string]bool)
+		for _, label := range pr.Labels {
+			if seen[label] {
+				continue
+			}
+			seen[label] = true
+
+			stats, exists := byLabel[label]
+			if !exists {
+				stats = &LabelStats{Label: label}
+				byLabel[label] = stats
+				repositories[label] = make(map[string]bool)
+			}
+			stats.PRs++
+			if ba.IsBugLabel(pr.Repository, label) {
+				stats.BugPRs++
+			}
+			if !repositories[label][pr.Repository] {
+				repositories[label][pr.Repository] = true
+				stats.Repositories = append(stats.Repositories, pr.Repository)
+			}
+		}
+	}
+
+	labels := make([]*LabelStats, 0, len(byLabel))
+	for _, stats := range byLabel {
+		sort.Strings(stats.Repositories)
+		labels = append(labels, stats)
+	}
+	sort.Slice(labels, func(i, j int) bool {
+		if labels[i].PRs != labels[j].PRs {
+			return labels[i].PRs > labels[j].PRs
+		}
+		return labels[i].Label < labels[j].Label
+	})
+	return labels
+}
+
+// labelMapping describes whether a label is mapped to bug: "bug", "partial" (in some repositories) or "-"
+func labelMapping(stats *LabelStats) string {
+	switch {
+	case stats.BugPRs == 0:
+		return "-"
+	case stats.BugPRs == stats.PRs:
+		return "bug"
+	default:
+		return "partial"
+	}
+}
+
+// PrintLabelStats prints the labels with their PR counts and bug mapping
+func (r *Reporter) PrintLabelStats(labels []*LabelStats) {
+	separator := "=========================================================================================================================="
+	fmt.Println("\nLABELS TRONG CÁC REPOSITORIES ĐÃ SCAN:")
+	fmt.Println(separator)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "LABEL\tSỐ PR\tSỐ REPO\tBUG LABEL")
+	for _, stats := range labels {
+		_, _ = fmt.Fprintf(w, "%s\t%d\t%d\t%s\n", stats.Label, stats.PRs, len(stats.Repositories), labelMapping(stats))
+	}
+	_ = w.Flush()
+
+	fmt.Println(separator)
+}
+
+// ExportLabelStatsCSV exports the labels with their PR counts and bug mapping to CSV
+func (r *Reporter) ExportLabelStatsCSV(filename string, labels []*LabelStats) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = file.Close() }()
+
+	_, _ = fmt.Fprintln(file, "label,prs,bug_prs,mapping,repositories")
+
+	for _, stats := range labels {
+		_, _ = fmt.Fprintf(file, "\"%s\",%d,%d,%s,\"%s\"\n",
+			stats.Label, stats.PRs, stats.BugPRs, labelMapping(stats), strings.Join(stats.Repositories, "; "))
+	}
+
+	fmt.Printf("\nDanh sách labels đã được export vào: %s\n", filename)
+	return nil
+}
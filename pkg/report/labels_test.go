@@ -0,0 +1,33 @@
+package report
+
+import (
+	"testing"
+
+	"github.com/bug-crawler/pkg/analyzer"
+	"github.com/bug-crawler/pkg/platform"
+)
+
+func TestBuildLabelStats(t *testing.T) {
+	prs := []*platform.PullRequestData{
+		{Repository: "org/a", Labels: []string{"bug", "ui"}},
+		{Repository: "org/a", Labels: []string{"ui", "ui"}},
+		{Repository: "org/b", Labels: []string{"ui", "no-issue"}},
+		{Repository: "org/b", Labels: []string{"bug"}},
+		{Repository: "org/b"},
+	}
+
+	got := BuildLabelStats(prs, analyzer.NewBugAnalyzer())
+
+	if len(got) != 3 {
+		t.Fatalf("BuildLabelStats() returned %d labels, want 3", len(got))
+	}
+	if got[0].Label != "ui" || got[0].PRs != 3 || len(got[0].Repositories) != 2 || labelMapping(got[0]) != "-" {
+		t.Errorf("got[0] = %+v, want ui in 3 PRs of 2 repositories, not mapped", got[0])
+	}
+	if got[1].Label != "bug" || got[1].PRs != 2 || got[1].BugPRs != 2 || labelMapping(got[1]) != "bug" {
+		t.Errorf("got[1] = %+v, want bug in 2 PRs, mapped to bug", got[1])
+	}
+	if got[2].Label != "no-issue" || got[2].BugPRs != 0 {
+		t.Errorf("got[2] = %+v, want no-issue not mapped to bug", got[2])
+	}
+}
@@ -0,0 +1,221 @@
+package report
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/bug-crawler/pkg/analyzer"
+)
+
+// Percentiles summarizes a distribution of values
+type Percentiles struct {
+	Count int     `json:"count"`
+	P50   float64 `json:"p50"`
+	P75   float64 `json:"p75"`
+	P90   float64 `json:"p90"`
+}
+
+// LeadTimeStats contains the lead-time percentiles of a group of PRs. Durations are in hours.
+type LeadTimeStats struct {
+	Key          string      `json:"key"`
+	PRs          int         `json:"prs"`
+	FirstReview  Percentiles `json:"first_review_hours"`
+	Approval     Percentiles `json:"approval_hours"`
+	Merge        Percentiles `json:"merge_hours"`
+	ReviewRounds Percentiles `json:"review_rounds"`
+}
+
+// LeadTimeReport contains lead-time statistics overall, per repository and per author
+type LeadTimeReport struct {
+	Overall      *LeadTimeStats   `json:"overall"`
+	ByRepository []*LeadTimeStats `json:"by_repository"`
+	ByAuthor     []*LeadTimeStats `json:"by_author"`
+}
+
+// leadTimeSamples collects the raw values of a group
+type leadTimeSamples struct {
+	prs          int
+	firstReview  []float64
+	approval     []float64
+	merge        []float64
+	reviewRounds []float64
+}
+
+func (s *leadTimeSamples) add(metrics *analyzer.PRMetrics) {
+	s.prs++
+	appendHours := func(values []float64, d *time.Duration) []float64 {
+		if d == nil {
+			return values
+		}
+		return append(values, d.Hours())
+	}
+	s.firstReview = appendHours(s.firstReview, metrics.TimeToFirstReview)
+	s.approval = appendHours(s.approval, metrics.TimeToApproval)
+	s.merge = appendHours(s.merge, metrics.TimeToMerge)
+	if metrics.ReviewRounds > 0 {
+		s.reviewRounds = append(s.reviewRounds, float64(metrics.ReviewRounds))
+	}
+}
+
+func (s *leadTimeSamples) stats(key string) *LeadTimeStats {
+	return &LeadTimeStats{
+		Key:          key,
+		PRs:          s.prs,
+		FirstReview:  computePercentiles(s.firstReview),
+		Approval:     computePercentiles(s.approval),
+		Merge:        computePercentiles(s.merge),
+		ReviewRounds: computePercentiles(s.reviewRounds),
+	}
+}
+
+// BuildLeadTimeReport aggregates PR metrics overall, per repository and per author.
+// Groups are sorted by number of PRs, largest first.
+func BuildLeadTimeReport(metrics []*analyzer.PRMetrics) *LeadTimeReport {
+	overall := &leadTimeSamples{}
+	byRepository := make(map[string]*leadTimeSamples)
+	byAuthor := make(map[string]*leadTimeSamples)
+
+	group := func(groups map[string]*leadTimeSamples, key string) *leadTimeSamples {
+		samples, exists := groups[key]
+		if !exists {
+			samples = &leadTimeSamples{}
+			groups[key] = samples
+		}
+		return samples
+	}
+
+	for _, m := range metrics {
+		overall.add(m)
+		group(byRepository, m.PR.Repository).add(m)
+		group(byAuthor, m.PR.Author).add(m)
+	}
+
+	return &LeadTimeReport{
+		Overall:      overall.stats("all"),
+		ByRepository: sortedLeadTimeStats(byRepository),
+		ByAuthor:     sortedLeadTimeStats(byAuthor),
+	}
+}
+
+// sortedLeadTimeStats computes the stats of every group, sorted by number of PRs then key
+func sortedLeadTimeStats(groups map[string]*leadTimeSamples) []*LeadTimeStats {
+	stats := make([]*LeadTimeStats, 0, len(groups))
+	for key, samples := range groups {
+		stats = append(stats, samples.stats(key))
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].PRs != stats[j].PRs {
+			return stats[i].PRs > stats[j].PRs
+		}
+		return stats[i].Key < stats[j].Key
+	})
+	return stats
+}
+
+// computePercentiles returns the 50th, 75th and 90th percentiles of the values
+func computePercentiles(values []float64) Percentiles {
+	if len(values) == 0 {
+		return Percentiles{}
+	}
+	sort.Float64s(values)
+	return Percentiles{
+		Count: len(values),
+		P50:   percentile(values, 50),
+		P75:   percentile(values, 75),
+		P90:   percentile(values, 90),
+	}
+}
+
+// percentile returns the p-th percentile of sorted values, interpolating linearly between ranks
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 1 {
+		return sorted[0]
+	}
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(rank)
+	if lower >= len(sorted)-1 {
+		return sorted[len(sorted)-1]
+	}
+	return sorted[lower] + (rank-float64(lower))*(sorted[lower+1]-sorted[lower])
+}
+
+// formatPercentiles formats the median and 90th percentile, "-" without values
+func formatPercentiles(p Percentiles) string {
+	if p.Count == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f / %.1f", p.P50, p.P90)
+}
+
+// PrintLeadTimeReport prints lead-time percentiles per repository and per author
+func (r *Reporter) PrintLeadTimeReport(leadTime *LeadTimeReport) {
+	if leadTime.Overall.PRs == 0 {
+		return
+	}
+
+	separator := "=========================================================================================================================="
+	fmt.Println("\nLEAD TIME (GIỜ, P50 / P90):")
+	fmt.Println(separator)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "NHÓM\tPR\tFIRST REVIEW\tAPPROVAL\tMERGE\tREVIEW ROUNDS")
+
+	printGroup := func(label string, stats []*LeadTimeStats) {
+		for _, s := range stats {
+			_, _ = fmt.Fprintf(w, "%s%s\t%d\t%s\t%s\t%s\t%s\n",
+				label,
+				s.Key,
+				s.PRs,
+				formatPercentiles(s.FirstReview),
+				formatPercentiles(s.Approval),
+				formatPercentiles(s.Merge),
+				formatPercentiles(s.ReviewRounds))
+		}
+	}
+
+	printGroup("", []*LeadTimeStats{leadTime.Overall})
+	printGroup("repo: ", leadTime.ByRepository)
+	printGroup("author: ", leadTime.ByAuthor)
+
+	_ = w.Flush()
+	fmt.Println(separator)
+}
+
+// ExportLeadTimeCSV exports lead-time percentiles to CSV, one row per group and metric
+func (r *Reporter) ExportLeadTimeCSV(filename string, leadTime *LeadTimeReport) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = file.Close() }()
+
+	_, _ = fmt.Fprintln(file, "group,key,prs,metric,count,p50,p75,p90")
+
+	writeGroup := func(group string, stats []*LeadTimeStats) {
+		for _, s := range stats {
+			metrics := []struct {
+				name        string
+				percentiles Percentiles
+			}{
+				{"first_review_hours", s.FirstReview},
+				{"approval_hours", s.Approval},
+				{"merge_hours", s.Merge},
+				{"review_rounds", s.ReviewRounds},
+			}
+			for _, m := range metrics {
+				_, _ = fmt.Fprintf(file, "%s,\"%s\",%d,%s,%d,%.2f,%.2f,%.2f\n",
+					group, s.Key, s.PRs, m.name, m.percentiles.Count, m.percentiles.P50, m.percentiles.P75, m.percentiles.P90)
+			}
+		}
+	}
+
+	writeGroup("overall", []*LeadTimeStats{leadTime.Overall})
+	writeGroup("repository", leadTime.ByRepository)
+	writeGroup("author", leadTime.ByAuthor)
+
+	fmt.Printf("\nLead time đã được export vào: %s\n", filename)
+	return nil
+}
@@ -0,0 +1,72 @@
+package report
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bug-crawler/pkg/analyzer"
+	"github.com/bug-crawler/pkg/platform"
+)
+
+func TestComputePercentiles(t *testing.T) {
+	got := computePercentiles([]float64{10, 1, 4, 2, 3})
+	want := Percentiles{Count: 5, P50: 3, P75: 4, P90: 7.6}
+	if got.Count != want.Count || got.P50 != want.P50 || got.P75 != want.P75 || math.Abs(got.P90-want.P90) > 1e-9 {
+		t.Errorf("computePercentiles() = %+v, want %+v", got, want)
+	}
+
+	if got := computePercentiles(nil); got.Count != 0 {
+		t.Errorf("computePercentiles(nil) = %+v, want zero", got)
+	}
+	if got := computePercentiles([]float64{2}); got.P50 != 2 || got.P90 != 2 {
+		t.Errorf("computePercentiles(single) = %+v, want 2", got)
+	}
+}
+
+func TestBuildLeadTimeReport(t *testing.T) {
+	hours := func(h int) *time.Duration {
+		d := time.Duration(h) * time.Hour
+		return &d
+	}
+	metrics := []*analyzer.PRMetrics{
+		{PR: &platform.PullRequestData{Repository: "org/a", Author: "alice"}, TimeToFirstReview: hours(2), TimeToMerge: hours(10), ReviewRounds: 1},
+		{PR: &platform.PullRequestData{Repository: "org/a", Author: "bob"}, TimeToFirstReview: hours(4), TimeToApproval: hours(6), ReviewRounds: 2},
+		{PR: &platform.PullRequestData{Repository: "org/b", Author: "alice"}},
+	}
+
+	leadTime := BuildLeadTimeReport(metrics)
+
+	if leadTime.Overall.PRs != 3 || leadTime.Overall.FirstReview.Count != 2 || leadTime.Overall.FirstReview.P50 != 3 {
+		t.Errorf("Overall = %+v", leadTime.Overall)
+	}
+	if leadTime.Overall.Approval.Count != 1 || leadTime.Overall.Merge.Count != 1 || leadTime.Overall.ReviewRounds.P50 != 1.5 {
+		t.Errorf("Overall = %+v", leadTime.Overall)
+	}
+	if len(leadTime.ByRepository) != 2 || leadTime.ByRepository[0].Key != "org/a" || leadTime.ByRepository[0].PRs != 2 {
+		t.Errorf("ByRepository[0] = %+v", leadTime.ByRepository[0])
+	}
+	if len(leadTime.ByAuthor) != 2 || leadTime.ByAuthor[0].Key != "alice" || leadTime.ByAuthor[0].Merge.P50 != 10 {
+		t.Errorf("ByAuthor[0] = %+v", leadTime.ByAuthor[0])
+	}
+
+	filename := filepath.Join(t.TempDir(), "lead_time.csv")
+	if err := NewReporter().ExportLeadTimeCSV(filename, leadTime); err != nil {
+		t.Fatalf("ExportLeadTimeCSV() error = %v", err)
+	}
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	// Header + 5 groups x 4 metrics
+	if len(lines) != 21 {
+		t.Fatalf("CSV has %d lines, want 21", len(lines))
+	}
+	if lines[1] != `overall,"all",3,first_review_hours,2,3.00,3.50,3.80` {
+		t.Errorf("CSV row = %q", lines[1])
+	}
+}
@@ -3,20 +3,35 @@ package report
 import (
 	"fmt"
 	"os"
+	"sort"
+	"strings"
 	"text/tabwriter"
 
 	"github.com/bug-crawler/pkg/analyzer"
 )
 
+// unclassified is the severity or category key of bug-related PRs that could not be classified
+const unclassified = "(none)"
+
 // Statistics contains bug statistics
 type Statistics struct {
-	TotalPRsCrawled int // Total number of PRs crawled
-	TotalPRs        int // Number of PRs in the result (may be filtered)
+	TotalPRsCrawled int            // Total number of PRs crawled
+	ExcludedPRs     map[string]int // PRs left out by the author filter per reason, not counted in TotalPRsCrawled
+	TotalPRs        int            // Number of PRs in the result (may be filtered)
 	BugRelatedPRs   int
 	ByKeyword       int
 	ByLabel         int
+	ByBranch        int
+	ByTitle         int
+	ByLinkedIssue   int
+	BySignal        map[string]int // Combined mode: bug-related PRs per fired signal
 	ByBugReview     int
-	TotalBugCount   int // Total number of bugs from bug_review tags
+	TotalBugCount   int            // Total number of bugs from bug_review tags
+	BugsBySeverity  map[string]int // bug_review breakdown per severity over all bug-related PRs
+	BugsByCategory  map[string]int // bug_review breakdown per category over all bug-related PRs
+	BugsByReviewer  map[string]int // Bugs of the bug_review tags in review comments, per reviewer
+	PRsBySeverity   map[string]int // Bug-related PRs per severity, unclassified ones under "(none)"
+	PRsByCategory   map[string]int // Bug-related PRs per category, unclassified ones under "(none)"
 	BugPercentage   float64
 	DetailedResults []*analyzer.BugResult
 }
@@ -35,9 +50,18 @@ func (r *Reporter) GenerateStatistics(results []*analyzer.BugResult) *Statistics
 		TotalPRsCrawled: len(results), // Update if information from main is available
 		TotalPRs:        len(results),
 		DetailedResults: results,
+		BySignal:        make(map[string]int),
+		BugsBySeverity:  make(map[string]int),
+		BugsByCategory:  make(map[string]int),
+		BugsByReviewer:  make(map[string]int),
+		PRsBySeverity:   make(map[string]int),
+		PRsByCategory:   make(map[string]int),
 	}
 
 	byLabel := 0
+	byBranch := 0
+	byTitle := 0
+	byLinkedIssue := 0
 	byBugReview := 0
 	totalBugCount := 0
 	bugCount := 0
@@ -45,18 +69,43 @@ func (r *Reporter) GenerateStatistics(results []*analyzer.BugResult) *Statistics
 	for _, result := range results {
 		if result.IsBugRelated {
 			bugCount++
+			for severity, n := range result.BugsBySeverity {
+				stats.BugsBySeverity[severity] += n
+			}
+			for category, n := range result.BugsByCategory {
+				stats.BugsByCategory[category] += n
+			}
+			for reviewer, n := range result.BugsByReviewer {
+				stats.BugsByReviewer[reviewer] += n
+			}
+			stats.PRsBySeverity[classOrNone(result.Severity)]++
+			stats.PRsByCategory[classOrNone(result.Category)]++
 			switch result.DetectionType {
 			case "label":
 				byLabel++
+			case "branch":
+				byBranch++
+			case "title":
+				byTitle++
+			case "linked_issue":
+				byLinkedIssue++
 			case "bug_review":
 				byBugReview++
 				totalBugCount += result.BugCount
+			case "combined":
+				totalBugCount += result.BugCount
+				for _, signal := range result.Signals {
+					stats.BySignal[signal.Type]++
+				}
 			}
 		}
 	}
 
 	stats.BugRelatedPRs = bugCount
 	stats.ByLabel = byLabel
+	stats.ByBranch = byBranch
+	stats.ByTitle = byTitle
+	stats.ByLinkedIssue = byLinkedIssue
 	stats.ByBugReview = byBugReview
 	stats.TotalBugCount = totalBugCount
 
@@ -72,12 +121,54 @@ func (r *Reporter) PrintSummary(stats *Statistics) {
 	fmt.Println("THỐNG KÊ BUG")
 	fmt.Println(separator)
 	fmt.Printf("Tổng số PR được crawl: %d\n", stats.TotalPRsCrawled)
+	r.PrintExcludedPRs(stats.ExcludedPRs)
 	fmt.Printf("PR liên quan bug: %d\n", stats.BugRelatedPRs)
 	if stats.ByBugReview > 0 {
 		fmt.Printf("  ├─ Phát hiện qua bug_review tag: %d (Tổng bugs: %d)\n", stats.ByBugReview, stats.TotalBugCount)
 	}
 	if stats.ByLabel > 0 {
-		fmt.Printf("  └─ Phát hiện qua label: %d\n", stats.ByLabel)
+		fmt.Printf("  ├─ Phát hiện qua label: %d\n", stats.ByLabel)
+	}
+	if stats.ByBranch > 0 {
+		fmt.Printf("  ├─ Phát hiện qua tên branch: %d\n", stats.ByBranch)
+	}
+	if stats.ByTitle > 0 {
+		fmt.Printf("  ├─ Phát hiện qua tiêu đề PR: %d\n", stats.ByTitle)
+	}
+	if stats.ByLinkedIssue > 0 {
+		fmt.Printf("  └─ Phát hiện qua issue liên kết: %d\n", stats.ByLinkedIssue)
+	}
+	if len(stats.BySignal) > 0 {
+		fmt.Println("  Tín hiệu (1 PR có thể có nhiều tín hiệu, chỉ tính 1 lần):")
+		signals := make([]string, 0, len(stats.BySignal))
+		for signal := range stats.BySignal {
+			signals = append(signals, signal)
+		}
+		sort.Slice(signals, func(i, j int) bool {
+			if stats.BySignal[signals[i]] != stats.BySignal[signals[j]] {
+				return stats.BySignal[signals[i]] > stats.BySignal[signals[j]]
+			}
+			return signals[i] < signals[j]
+		})
+		for _, signal := range signals {
+			fmt.Printf("  ├─ %s: %d\n", signal, stats.BySignal[signal])
+		}
+		if stats.TotalBugCount > 0 {
+			fmt.Printf("  └─ Tổng bugs từ bug_review: %d\n", stats.TotalBugCount)
+		}
+	}
+	if breakdown := formatBreakdown(stats.BugsBySeverity, stats.BugsByCategory, ", "); breakdown != "" {
+		fmt.Printf("Bugs từ bug_review theo mức độ/loại: %s\n", breakdown)
+	}
+	if len(stats.BugsByReviewer) > 0 {
+		fmt.Printf("Bugs phát hiện trong review comment theo reviewer: %s\n", formatBugsByReviewer(stats.BugsByReviewer, ", "))
+	}
+	if stats.PRsBySeverity[unclassified] < stats.BugRelatedPRs {
+		severities := append(append([]string{}, analyzer.Severities...), unclassified)
+		printClassification("PR bug theo mức độ:", severities, stats.PRsBySeverity, stats.BugRelatedPRs)
+	}
+	if stats.PRsByCategory[unclassified] < stats.BugRelatedPRs {
+		printClassification("PR bug theo loại:", sortedClassification(stats.PRsByCategory), stats.PRsByCategory, stats.BugRelatedPRs)
 	}
 	if stats.TotalPRsCrawled > 0 {
 		fmt.Printf("Tỷ lệ bug: %.2f%%\n", stats.BugPercentage)
@@ -85,6 +176,25 @@ func (r *Reporter) PrintSummary(stats *Statistics) {
 	fmt.Println(separator)
 }
 
+// PrintExcludedPRs prints the number of PRs left out by the author filter, per reason
+func (r *Reporter) PrintExcludedPRs(excluded map[string]int) {
+	total := 0
+	for _, n := range excluded {
+		total += n
+	}
+	if total == 0 {
+		return
+	}
+
+	var parts []string
+	for _, reason := range []string{analyzer.ExcludedBot, analyzer.ExcludedByExclude, analyzer.ExcludedNotIncluded} {
+		if excluded[reason] > 0 {
+			parts = append(parts, fmt.Sprintf("%s: %d", reason, excluded[reason]))
+		}
+	}
+	fmt.Printf("PR bị loại theo author (không tính vào tổng): %d (%s)\n", total, strings.Join(parts, ", "))
+}
+
 // PrintDetails prints details of each PR
 func (r *Reporter) PrintDetails(stats *Statistics) {
 	if len(stats.DetailedResults) == 0 {
@@ -106,9 +216,15 @@ func (r *Reporter) PrintDetails(stats *Statistics) {
 			}
 
 			detailInfo := ""
-			if result.DetectionType == "bug_review" {
+			switch result.DetectionType {
+			case "bug_review":
 				detailInfo = fmt.Sprintf("%d bugs", result.BugCount)
-			} else {
+				if breakdown := formatBreakdown(result.BugsBySeverity, result.BugsByCategory, ", "); breakdown != "" {
+					detailInfo += " (" + breakdown + ")"
+				}
+			case "combined":
+				detailInfo = fmt.Sprintf("%s (%.2f)", result.MatchedKeyword, result.Confidence)
+			default:
 				detailInfo = result.MatchedKeyword
 			}
 
@@ -133,16 +249,16 @@ func (r *Reporter) ExportCSV(filename string, stats *Statistics) error {
 	}
 	defer func() { _ = file.Close() }()
 
-	_, _ = fmt.Fprintln(file, "PR#,Title,Author,Detection Type,Matched Keyword,Number Bug,Date Opened,URL")
+	_, _ = fmt.Fprintln(file, "PR#,Title,Author,Detection Type,Matched Keyword,Number Bug,Date Opened,URL,Bug Breakdown,Bug Reviewers,Severity,Category")
 
 	for _, result := range stats.DetailedResults {
 		if result.IsBugRelated {
 			numberBug := 1
-			if result.DetectionType == "bug_review" {
+			if result.BugCount > 0 {
 				numberBug = result.BugCount
 			}
 
-			_, _ = fmt.Fprintf(file, "%d,\"%s\",%s,%s,%s,%d,%s,%s\n",
+			_, _ = fmt.Fprintf(file, "%d,\"%s\",%s,%s,%s,%d,%s,%s,\"%s\",\"%s\",%s,\"%s\"\n",
 				result.PR.Number,
 				result.PR.Title,
 				result.PR.Author,
@@ -151,6 +267,10 @@ func (r *Reporter) ExportCSV(filename string, stats *Statistics) error {
 				numberBug,
 				result.PR.CreatedAt.Format("2006-01-02"),
 				result.PR.HTMLURL,
+				formatBreakdown(result.BugsBySeverity, result.BugsByCategory, ";"),
+				formatBugsByReviewer(result.BugsByReviewer, ";"),
+				result.Severity,
+				result.Category,
 			)
 		}
 	}
@@ -159,6 +279,95 @@ func (r *Reporter) ExportCSV(filename string, stats *Statistics) error {
 	return nil
 }
 
+// classOrNone returns the severity or category of a PR, unclassified if empty
+func classOrNone(class string) string {
+	if class == "" {
+		return unclassified
+	}
+	return class
+}
+
+// sortedClassification sorts categories by number of PRs, unclassified last
+func sortedClassification(counts map[string]int) []string {
+	classes := make([]string, 0, len(counts))
+	for class := range counts {
+		if class != unclassified {
+			classes = append(classes, class)
+		}
+	}
+	sort.Slice(classes, func(i, j int) bool {
+		if counts[classes[i]] != counts[classes[j]] {
+			return counts[classes[i]] > counts[classes[j]]
+		}
+		return classes[i] < classes[j]
+	})
+	return append(classes, unclassified)
+}
+
+// printClassification prints the bug-related PRs of each class with their share of all bug-related PRs
+func printClassification(title string, classes []string, counts map[string]int, total int) {
+	var shown []string
+	for _, class := range classes {
+		if counts[class] > 0 {
+			shown = append(shown, class)
+		}
+	}
+
+	fmt.Println(title)
+	for i, class := range shown {
+		branch := "├─"
+		if i == len(shown)-1 {
+			branch = "└─"
+		}
+		fmt.Printf("  %s %s: %d (%.1f%%)\n", branch, class, counts[class], percentage(counts[class], total))
+	}
+}
+
+// formatBugsByReviewer formats the bugs found by each reviewer, most bugs first, e.g. "alice: 3, bob: 1"
+func formatBugsByReviewer(byReviewer map[string]int, sep string) string {
+	reviewers := make([]string, 0, len(byReviewer))
+	for reviewer, n := range byReviewer {
+		if n > 0 {
+			reviewers = append(reviewers, reviewer)
+		}
+	}
+	sort.Slice(reviewers, func(i, j int) bool {
+		if byReviewer[reviewers[i]] != byReviewer[reviewers[j]] {
+			return byReviewer[reviewers[i]] > byReviewer[reviewers[j]]
+		}
+		return reviewers[i] < reviewers[j]
+	})
+
+	parts := make([]string, len(reviewers))
+	for i, reviewer := range reviewers {
+		parts[i] = fmt.Sprintf("%s: %d", reviewer, byReviewer[reviewer])
+	}
+	return strings.Join(parts, sep)
+}
+
+// formatBreakdown formats a bug_review breakdown, severities first from the most severe then categories
+// alphabetically, e.g. "major: 1, minor: 2, ui: 1"
+func formatBreakdown(bySeverity, byCategory map[string]int, sep string) string {
+	var parts []string
+	for _, severity := range analyzer.Severities {
+		if n := bySeverity[severity]; n > 0 {
+			parts = append(parts, fmt.Sprintf("%s: %d", severity, n))
+		}
+	}
+
+	categories := make([]string, 0, len(byCategory))
+	for category, n := range byCategory {
+		if n > 0 {
+			categories = append(categories, category)
+		}
+	}
+	sort.Strings(categories)
+	for _, category := range categories {
+		parts = append(parts, fmt.Sprintf("%s: %d", category, byCategory[category]))
+	}
+	return strings.Join(parts, sep)
+}
+
 // ExportPRRulesCSV export PR rule validation results to CSV
 func (r *Reporter) ExportPRRulesCSV(filename string, results []*analyzer.PRRuleResult) error {
 	file, err := os.Create(filename)
@@ -168,11 +377,25 @@ func (r *Reporter) ExportPRRulesCSV(filename string, results []*analyzer.PRRuleR
 	defer func() { _ = file.Close() }()
 
 	// Write header
-	_, _ = fmt.Fprintln(file, "pr_number,pr_title,author,pr_status,pr_description_valid,review_comment_valid,pr_compliant,url")
+	_, _ = fmt.Fprintln(file, "pr_number,pr_title,author,pr_status,pr_description_valid,review_comment_valid,pr_compliant,url,failed_rules,missing_keywords,matched_keywords,checklist_checked,checklist_total,approval_valid,approvals,reviewers")
 
 	// Write data rows
 	for _, result := range results {
-		_, _ = fmt.Fprintf(file, "%d,\"%s\",%s,%s,%v,%v,%v,%s\n",
+		var failedRules []string
+		for _, ruleResult := range result.RuleResults {
+			if !ruleResult.Passed {
+				failedRules = append(failedRules, ruleResult.Name)
+			}
+		}
+
+		checked, total := checklistTotals(result.RuleResults)
+
+		approvalValid := fmt.Sprint(result.ApprovalValid)
+		if result.ApprovalUnknown {
+			approvalValid = "n/a"
+		}
+
+		_, _ = fmt.Fprintf(file, "%d,\"%s\",%s,%s,%v,%v,%v,%s,%s,\"%s\",\"%s\",%d,%d,%s,\"%s\",\"%s\"\n",
 			result.PR.Number,
 			result.PR.Title,
 			result.PR.Author,
@@ -181,6 +404,14 @@ func (r *Reporter) ExportPRRulesCSV(filename string, results []*analyzer.PRRuleR
 			result.ReviewCommentValid,
 			result.PRCompliant,
 			result.PR.HTMLURL,
+			strings.Join(failedRules, ";"),
+			formatMissingKeywords(result.RuleResults, false),
+			formatMatchedKeywords(result.RuleResults),
+			checked,
+			total,
+			approvalValid,
+			strings.Join(approvers(result.RuleResults), ";"),
+			formatReviewers(result.RuleResults, ";"),
 		)
 	}
 
@@ -197,6 +428,15 @@ func (r *Reporter) PrintPRRulesSummary(results []*analyzer.PRRuleResult) {
 	compliantCount := 0
 	descValidCount := 0
 	reviewCommentValidCount := 0
+	approvalValidCount := 0
+	approvalUnknownCount := 0 // PRs whose platform does not report approvals
+	reviewerEvaluations := 0
+	reviewerPassed := 0
+	ruleTotals := make(map[string]int)
+	rulePassed := make(map[string]int)
+	var ruleNames []string
+	checklists := make(map[string]*checklistSummary)
+	var checklistNames []string
 
 	for _, result := range results {
 		if result.PRCompliant {
@@ -208,6 +448,38 @@ func (r *Reporter) PrintPRRulesSummary(results []*analyzer.PRRuleResult) {
 		if result.ReviewCommentValid {
 			reviewCommentValidCount++
 		}
+		if result.ApprovalUnknown {
+			approvalUnknownCount++
+		} else if result.ApprovalValid {
+			approvalValidCount++
+		}
+		for _, ruleResult := range result.RuleResults {
+			if ruleResult.Approval != nil && ruleResult.Approval.Unavailable {
+				continue
+			}
+			if _, exists := ruleTotals[ruleResult.Name]; !exists {
+				ruleNames = append(ruleNames, ruleResult.Name)
+			}
+			ruleTotals[ruleResult.Name]++
+			if ruleResult.Passed {
+				rulePassed[ruleResult.Name]++
+			}
+			for _, reviewer := range ruleResult.Reviewers {
+				reviewerEvaluations++
+				if reviewer.Passed {
+					reviewerPassed++
+				}
+			}
+			if ruleResult.Checklist != nil {
+				summary, exists := checklists[ruleResult.Name]
+				if !exists {
+					summary = &checklistSummary{}
+					checklists[ruleResult.Name] = summary
+					checklistNames = append(checklistNames, ruleResult.Name)
+				}
+				summary.add(ruleResult.Checklist)
+			}
+		}
 	}
 
 	separator := "============================================================"
@@ -217,10 +489,130 @@ func (r *Reporter) PrintPRRulesSummary(results []*analyzer.PRRuleResult) {
 	fmt.Printf("Tổng số PR: %d\n", len(results))
 	fmt.Printf("PR Description hợp lệ: %d (%.1f%%)\n", descValidCount, float64(descValidCount)*100/float64(len(results)))
 	fmt.Printf("Review comment hợp lệ: %d (%.1f%%)\n", reviewCommentValidCount, float64(reviewCommentValidCount)*100/float64(len(results)))
+	if reviewerEvaluations > 0 {
+		fmt.Printf("Lượt review đạt rule (theo từng reviewer): %d/%d (%.1f%%)\n", reviewerPassed, reviewerEvaluations, percentage(reviewerPassed, reviewerEvaluations))
+	}
+	if approvalUnknownCount == len(results) {
+		fmt.Println("PR đủ approval: n/a (platform không cung cấp approval)")
+	} else {
+		fmt.Printf("PR đủ approval: %d (%.1f%%)\n", approvalValidCount, percentage(approvalValidCount, len(results)-approvalUnknownCount))
+	}
 	fmt.Printf("PR tuân thủ đầy đủ: %d (%.1f%%)\n", compliantCount, float64(compliantCount)*100/float64(len(results)))
+	if len(ruleNames) > 0 {
+		fmt.Println("Theo từng rule:")
+		for i, name := range ruleNames {
+			branch := "├─"
+			if i == len(ruleNames)-1 {
+				branch = "└─"
+			}
+			fmt.Printf("  %s %s: %d/%d (%.1f%%)\n", branch, name, rulePassed[name], ruleTotals[name], percentage(rulePassed[name], ruleTotals[name]))
+		}
+	}
+	for _, name := range checklistNames {
+		summary := checklists[name]
+		fmt.Printf("Checklist %s:\n", name)
+		fmt.Printf("  ├─ PR có checklist: %d/%d\n", summary.withChecklist, summary.prs)
+		fmt.Printf("  ├─ Item đã check: %d/%d (%.1f%%)\n", summary.checked, summary.total, percentage(summary.checked, summary.total))
+		fmt.Printf("  ├─ Hoàn thành trung bình mỗi PR: %.1f%%\n", summary.averageCompletion())
+		fmt.Printf("  └─ PR check đủ 100%%: %d\n", summary.complete)
+	}
 	fmt.Println(separator)
 }
 
+// checklistSummary aggregates the checklist results of one rule set over all PRs
+type checklistSummary struct {
+	prs            int     // PRs evaluated by the rule set
+	withChecklist  int     // PRs with at least one checklist item
+	complete       int     // PRs with every item checked
+	checked        int     // Checked items over all PRs
+	total          int     // Items over all PRs
+	completionRate float64 // Sum of the per-PR completion rates
+}
+
+func (cs *checklistSummary) add(checklist *analyzer.ChecklistResult) {
+	cs.prs++
+	if checklist.Total() == 0 {
+		return
+	}
+	cs.withChecklist++
+	if checklist.Unchecked == 0 {
+		cs.complete++
+	}
+	cs.checked += checklist.Checked
+	cs.total += checklist.Total()
+	cs.completionRate += checklist.CompletionRate()
+}
+
+// averageCompletion returns the mean completion rate of the PRs having a checklist
+func (cs *checklistSummary) averageCompletion() float64 {
+	if cs.withChecklist == 0 {
+		return 0
+	}
+	return cs.completionRate / float64(cs.withChecklist)
+}
+
+// formatReviewers lists the per-reviewer result of every review rule set, e.g. "alice ✓;bob ✗".
+// A reviewer is marked ✓ only if they passed all review rule sets, "-" if they approved without commenting.
+func formatReviewers(ruleResults []*analyzer.RuleResult, sep string) string {
+	var reviewers []string
+	passed := make(map[string]bool)
+	approveOnly := make(map[string]bool)
+	for _, ruleResult := range ruleResults {
+		for _, reviewer := range ruleResult.Reviewers {
+			current, exists := passed[reviewer.Reviewer]
+			if !exists {
+				reviewers = append(reviewers, reviewer.Reviewer)
+				current = true
+			}
+			passed[reviewer.Reviewer] = current && reviewer.Passed
+			approveOnly[reviewer.Reviewer] = reviewer.ApproveOnly
+		}
+	}
+
+	parts := make([]string, 0, len(reviewers))
+	for _, reviewer := range reviewers {
+		mark := "✓"
+		switch {
+		case approveOnly[reviewer]:
+			mark = "-" // Approved without commenting, not evaluated
+		case !passed[reviewer]:
+			mark = "✗"
+		}
+		parts = append(parts, reviewer+" "+mark)
+	}
+	return strings.Join(parts, sep)
+}
+
+// approvers lists the reviewers who approved a PR, over all approval rule sets
+func approvers(ruleResults []*analyzer.RuleResult) []string {
+	var logins []string
+	seen := make(map[string]bool)
+	for _, ruleResult := range ruleResults {
+		if ruleResult.Approval == nil {
+			continue
+		}
+		for _, login := range ruleResult.Approval.Approvers {
+			if !seen[login] {
+				seen[login] = true
+				logins = append(logins, login)
+			}
+		}
+	}
+	return logins
+}
+
+// checklistTotals sums checked and total checklist items over the checklist rule sets of a PR
+func checklistTotals(ruleResults []*analyzer.RuleResult) (int, int) {
+	checked, total := 0, 0
+	for _, ruleResult := range ruleResults {
+		if ruleResult.Checklist != nil {
+			checked += ruleResult.Checklist.Checked
+			total += ruleResult.Checklist.Total()
+		}
+	}
+	return checked, total
+}
+
 // PrintPRRulesDetails prints PR rules validation results
 func (r *Reporter) PrintPRRulesDetails(results []*analyzer.PRRuleResult) {
 	if len(results) == 0 {
@@ -232,7 +624,7 @@ func (r *Reporter) PrintPRRulesDetails(results []*analyzer.PRRuleResult) {
 	fmt.Println(separator)
 
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	_, _ = fmt.Fprintln(w, "PR#\tTITLE\tDESC\tREVIEW\tCOMPLIANT")
+	_, _ = fmt.Fprintln(w, "PR#\tTITLE\tDESC\tREVIEW\tAPPROVAL\tCOMPLIANT\tCHECKLIST\tREVIEWERS\tTHIẾU\tTÌM THẤY")
 
 	count := 0
 	for _, result := range results {
@@ -253,20 +645,37 @@ func (r *Reporter) PrintPRRulesDetails(results []*analyzer.PRRuleResult) {
 				review = "✗"
 			}
 
+			approval := "✓"
+			if result.ApprovalUnknown {
+				approval = "n/a"
+			} else if !result.ApprovalValid {
+				approval = "✗"
+			}
+
 			compliant := "✓"
 			if !result.PRCompliant {
 				compliant = "✗"
 			}
 
-			_, _ = fmt.Fprintf(w, "#%d\t%s\t%s\t%s\t%s\n",
+			checklist := "-"
+			if checked, total := checklistTotals(result.RuleResults); total > 0 {
+				checklist = fmt.Sprintf("%d/%d", checked, total)
+			}
+
+			_, _ = fmt.Fprintf(w, "#%d\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
 				result.PR.Number,
 				title,
 				desc,
 				review,
-				compliant)
+				approval,
+				compliant,
+				checklist,
+				formatReviewers(result.RuleResults, ", "),
+				formatMissingKeywords(result.RuleResults, true),
+				formatMatchedKeywords(result.RuleResults))
 
 			if count >= 20 {
-				_, _ = fmt.Fprintf(w, "...\t(Còn %d PR không tuân thủ)\t\t\t\n", len(results)-count)
+				_, _ = fmt.Fprintf(w, "...\t(Còn %d PR không tuân thủ)\t\t\t\t\t\t\t\t\n", len(results)-count)
 				break
 			}
 		}
@@ -275,3 +684,45 @@ func (r *Reporter) PrintPRRulesDetails(results []*analyzer.PRRuleResult) {
 	_ = w.Flush()
 	fmt.Println(separator)
 }
+
+// formatMissingKeywords lists missing keywords per rule set, e.g. "description_keywords: Security, Code Style".
+// With failedOnly, keywords of rule sets that passed anyway are left out.
+func formatMissingKeywords(ruleResults []*analyzer.RuleResult, failedOnly bool) string {
+	var parts []string
+	for _, ruleResult := range ruleResults {
+		if len(ruleResult.Missing) == 0 || (failedOnly && ruleResult.Passed) {
+			continue
+		}
+		empty := make(map[string]bool, len(ruleResult.Empty))
+		for _, keyword := range ruleResult.Empty {
+			empty[keyword] = true
+		}
+		missing := make([]string, 0, len(ruleResult.Missing))
+		for _, keyword := range ruleResult.Missing {
+			if empty[keyword] {
+				// The section heading exists but only contains template placeholders
+				keyword += " (trống)"
+			}
+			missing = append(missing, keyword)
+		}
+		parts = append(parts, fmt.Sprintf("%s: %s", ruleResult.Name, strings.Join(missing, ", ")))
+	}
+	return strings.Join(parts, " | ")
+}
+
+// formatMatchedKeywords lists matched keywords per rule set with the text they matched,
+// e.g. "review_keywords: Security «s1», Functionality «functionality»"
+func formatMatchedKeywords(ruleResults []*analyzer.RuleResult) string {
+	var parts []string
+	for _, ruleResult := range ruleResults {
+		if len(ruleResult.Matched) == 0 {
+			continue
+		}
+		matches := make([]string, 0, len(ruleResult.Matched))
+		for _, match := range ruleResult.Matched {
+			matches = append(matches, fmt.Sprintf("%s «%s»", match.Keyword, strings.ReplaceAll(match.Span, "\"", "'")))
+		}
+		parts = append(parts, fmt.Sprintf("%s: %s", ruleResult.Name, strings.Join(matches, ", ")))
+	}
+	return strings.Join(parts, " | ")
+}
@@ -2,6 +2,7 @@ package report
 
 import (
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -75,7 +76,7 @@ func TestExportCSV(t *testing.T) {
 	}
 
 	// Check header
-	expectedHeader := "PR#,Title,Author,Detection Type,Matched Keyword,Number Bug,Date Opened,URL"
+	expectedHeader := "PR#,Title,Author,Detection Type,Matched Keyword,Number Bug,Date Opened,URL,Bug Breakdown,Bug Reviewers,Severity,Category"
 	if lines[0] != expectedHeader {
 		t.Errorf("Header mismatch.\nExpected: %s\nGot:      %s", expectedHeader, lines[0])
 	}
@@ -93,3 +94,148 @@ func TestExportCSV(t *testing.T) {
 		t.Errorf("Row 2 should contain number_bug=3. Got: %s", lines[2])
 	}
 }
+
+func TestExportPRRulesCSV(t *testing.T) {
+	results := []*analyzer.PRRuleResult{
+		{
+			PR: &platform.PullRequestData{
+				Number:  7,
+				Title:   "Add login",
+				Author:  "user1",
+				Status:  "merged",
+				HTMLURL: "http://github.com/org/repo/pull/7",
+			},
+			PRDescriptionValid: false,
+			ReviewCommentValid: true,
+			ApprovalValid:      true,
+			RuleResults: []*analyzer.RuleResult{
+				{
+					Name:    "description_keywords",
+					Passed:  false,
+					Matched: []analyzer.KeywordMatch{{Keyword: "Description", Span: "desc"}},
+					Missing: []string{"Security", "Code Style"},
+				},
+				{
+					Name:   "review_keywords",
+					Passed: true,
+					Reviewers: []*analyzer.ReviewerRuleResult{
+						{Reviewer: "r1", Passed: true},
+						{Reviewer: "r2", Passed: false},
+					},
+					Matched: []analyzer.KeywordMatch{{Keyword: "Security", Span: "S1"}},
+					Missing: []string{"Code Readability"},
+				},
+				{
+					Name:      "self_review_checklist",
+					Passed:    true,
+					Checklist: &analyzer.ChecklistResult{Checked: 2, Unchecked: 1},
+				},
+				{
+					Name:     "approval",
+					Passed:   true,
+					Approval: &analyzer.ApprovalResult{Approvers: []string{"r1", "r2"}},
+				},
+			},
+		},
+	}
+
+	filename := filepath.Join(t.TempDir(), "pr_rules.csv")
+	if err := NewReporter().ExportPRRulesCSV(filename, results); err != nil {
+		t.Fatalf("ExportPRRulesCSV failed: %v", err)
+	}
+
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("Failed to read generated CSV: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 lines in CSV, got %d", len(lines))
+	}
+
+	expectedHeader := "pr_number,pr_title,author,pr_status,pr_description_valid,review_comment_valid,pr_compliant,url,failed_rules,missing_keywords,matched_keywords,checklist_checked,checklist_total,approval_valid,approvals,reviewers"
+	if lines[0] != expectedHeader {
+		t.Errorf("Header mismatch.\nExpected: %s\nGot:      %s", expectedHeader, lines[0])
+	}
+
+	expectedRow := `7,"Add login",user1,merged,false,true,false,http://github.com/org/repo/pull/7,description_keywords,` +
+		`"description_keywords: Security, Code Style | review_keywords: Code Readability",` +
+		`"description_keywords: Description «desc» | review_keywords: Security «S1»",2,3,true,"r1;r2","r1 ✓;r2 ✗"`
+	if lines[1] != expectedRow {
+		t.Errorf("Row mismatch.\nExpected: %s\nGot:      %s", expectedRow, lines[1])
+	}
+}
+
+func TestGenerateStatistics_Combined(t *testing.T) {
+	pr := &platform.PullRequestData{}
+	results := []*analyzer.BugResult{
+		{
+			PR: pr, IsBugRelated: true, DetectionType: "combined", BugCount: 2,
+			Signals: []analyzer.BugSignal{{Type: analyzer.SignalBugReview}, {Type: analyzer.SignalLabel}},
+		},
+		{
+			PR: pr, IsBugRelated: true, DetectionType: "combined",
+			Signals: []analyzer.BugSignal{{Type: analyzer.SignalLabel}},
+		},
+		{PR: pr, Signals: []analyzer.BugSignal{{Type: analyzer.SignalBranch}}}, // Below threshold
+	}
+
+	stats := NewReporter().GenerateStatistics(results)
+
+	if stats.BugRelatedPRs != 2 || stats.TotalBugCount != 2 {
+		t.Errorf("BugRelatedPRs = %d, TotalBugCount = %d, want 2, 2", stats.BugRelatedPRs, stats.TotalBugCount)
+	}
+	if stats.BySignal[analyzer.SignalLabel] != 2 || stats.BySignal[analyzer.SignalBugReview] != 1 || stats.BySignal[analyzer.SignalBranch] != 0 {
+		t.Errorf("BySignal = %v", stats.BySignal)
+	}
+}
+
+func TestGenerateStatistics_BugBreakdown(t *testing.T) {
+	results := []*analyzer.BugResult{
+		{
+			PR:             &platform.PullRequestData{Number: 1},
+			IsBugRelated:   true,
+			DetectionType:  "bug_review",
+			BugCount:       3,
+			BugsBySeverity: map[string]int{"major": 1, "minor": 2},
+			BugsByCategory: map[string]int{"ui": 1},
+		},
+		{
+			PR:             &platform.PullRequestData{Number: 2},
+			IsBugRelated:   true,
+			DetectionType:  "bug_review",
+			BugCount:       1,
+			BugsBySeverity: map[string]int{"critical": 1},
+			BugsByCategory: map[string]int{"data": 1},
+		},
+	}
+
+	stats := NewReporter().GenerateStatistics(results)
+	if stats.TotalBugCount != 4 {
+		t.Errorf("TotalBugCount = %d, want 4", stats.TotalBugCount)
+	}
+
+	got := formatBreakdown(stats.BugsBySeverity, stats.BugsByCategory, ", ")
+	want := "critical: 1, major: 1, minor: 2, data: 1, ui: 1"
+	if got != want {
+		t.Errorf("formatBreakdown() = %q, want %q", got, want)
+	}
+}
+
+func TestGenerateStatistics_Classification(t *testing.T) {
+	results := []*analyzer.BugResult{
+		{PR: &platform.PullRequestData{Number: 1}, IsBugRelated: true, DetectionType: "label", Severity: "major", Category: "ui"},
+		{PR: &platform.PullRequestData{Number: 2}, IsBugRelated: true, DetectionType: "label", Severity: "major"},
+		{PR: &platform.PullRequestData{Number: 3}, IsBugRelated: true, DetectionType: "label", Category: "ui"},
+		{PR: &platform.PullRequestData{Number: 4}, IsBugRelated: false, Severity: "critical"},
+	}
+
+	stats := NewReporter().GenerateStatistics(results)
+	if stats.PRsBySeverity["major"] != 2 || stats.PRsBySeverity[unclassified] != 1 || stats.PRsBySeverity["critical"] != 0 {
+		t.Errorf("PRsBySeverity = %v, want major 2, (none) 1", stats.PRsBySeverity)
+	}
+	if got := sortedClassification(stats.PRsByCategory); len(got) != 2 || got[0] != "ui" || got[1] != unclassified {
+		t.Errorf("sortedClassification() = %v, want [ui (none)]", got)
+	}
+}
@@ -0,0 +1,211 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/bug-crawler/pkg/analyzer"
+)
+
+// ReviewerStats contains the review activity and quality of one reviewer
+type ReviewerStats struct {
+	Reviewer         string   `json:"reviewer"`
+	PRsReviewed      int      `json:"prs_reviewed"`
+	Approvals        int      `json:"approvals"`
+	Comments         int      `json:"comments"`
+	AvgComments      float64  `json:"avg_comments_per_pr"`
+	KeywordCoverage  float64  `json:"keyword_coverage"` // Average % of review keywords mentioned per PR
+	MedianFirstHours *float64 `json:"median_first_review_hours"`
+	RulePassed       int      `json:"rule_passed"` // PRs where the reviewer's own comments passed the review rule sets
+	RulePassRate     float64  `json:"rule_pass_rate"`
+}
+
+// reviewerAccumulator collects the raw values behind ReviewerStats
+type reviewerAccumulator struct {
+	stats         *ReviewerStats
+	coverageSum   float64
+	firstReviewHs []float64
+}
+
+// BuildReviewerStats computes the reviewer leaderboard from PR-rules results.
+// Reviewers are those evaluated by the review rule sets (PR author and bots excluded),
+// sorted by rule pass rate, then by number of PRs reviewed.
+func BuildReviewerStats(results []*analyzer.PRRuleResult) []*ReviewerStats {
+	accumulators := make(map[string]*reviewerAccumulator)
+	var order []string
+
+	for _, result := range results {
+		reviewerResults := make(map[string][]*analyzer.ReviewerRuleResult)
+		var prReviewers []string
+		for _, ruleResult := range result.RuleResults {
+			for _, reviewerResult := range ruleResult.Reviewers {
+				if _, exists := reviewerResults[reviewerResult.Reviewer]; !exists {
+					prReviewers = append(prReviewers, reviewerResult.Reviewer)
+				}
+				reviewerResults[reviewerResult.Reviewer] = append(reviewerResults[reviewerResult.Reviewer], reviewerResult)
+			}
+		}
+		if len(prReviewers) == 0 {
+			continue
+		}
+
+		_, states := analyzer.LatestReviewStates(result.PR)
+
+		for _, reviewer := range prReviewers {
+			acc, exists := accumulators[reviewer]
+			if !exists {
+				acc = &reviewerAccumulator{stats: &ReviewerStats{Reviewer: reviewer}}
+				accumulators[reviewer] = acc
+				order = append(order, reviewer)
+			}
+			stats := acc.stats
+			stats.PRsReviewed++
+
+			if states[reviewer] == analyzer.ReviewStateApproved {
+				stats.Approvals++
+			}
+
+			var first *float64
+			for _, review := range result.PR.Reviews {
+				if review.ReviewerLogin != reviewer {
+					continue
+				}
+				if review.CommentBody != "" {
+					stats.Comments++
+				}
+				if review.SubmittedAt != nil && !review.SubmittedAt.Before(result.PR.CreatedAt) {
+					hours := review.SubmittedAt.Sub(result.PR.CreatedAt).Hours()
+					if first == nil || hours < *first {
+						first = &hours
+					}
+				}
+			}
+			if first != nil {
+				acc.firstReviewHs = append(acc.firstReviewHs, *first)
+			}
+
+			passed := true
+			matched, total := 0, 0
+			for _, reviewerResult := range reviewerResults[reviewer] {
+				passed = passed && reviewerResult.Passed
+				matched += len(reviewerResult.Matched)
+				total += len(reviewerResult.Matched) + len(reviewerResult.Missing)
+			}
+			if passed {
+				stats.RulePassed++
+			}
+			acc.coverageSum += percentage(matched, total)
+		}
+	}
+
+	reviewers := make([]*ReviewerStats, 0, len(order))
+	for _, reviewer := range order {
+		acc := accumulators[reviewer]
+		stats := acc.stats
+		stats.AvgComments = float64(stats.Comments) / float64(stats.PRsReviewed)
+		stats.KeywordCoverage = acc.coverageSum / float64(stats.PRsReviewed)
+		stats.RulePassRate = percentage(stats.RulePassed, stats.PRsReviewed)
+		if len(acc.firstReviewHs) > 0 {
+			median := computePercentiles(acc.firstReviewHs).P50
+			stats.MedianFirstHours = &median
+		}
+		reviewers = append(reviewers, stats)
+	}
+
+	sort.SliceStable(reviewers, func(i, j int) bool {
+		if reviewers[i].RulePassRate != reviewers[j].RulePassRate {
+			return reviewers[i].RulePassRate > reviewers[j].RulePassRate
+		}
+		return reviewers[i].PRsReviewed > reviewers[j].PRsReviewed
+	})
+
+	return reviewers
+}
+
+// formatHours formats an optional duration in hours, "-" if unknown
+func formatHours(hours *float64) string {
+	if hours == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f", *hours)
+}
+
+// PrintReviewerLeaderboard prints the reviewer leaderboard
+func (r *Reporter) PrintReviewerLeaderboard(reviewers []*ReviewerStats) {
+	if len(reviewers) == 0 {
+		return
+	}
+
+	separator := "=========================================================================================================================="
+	fmt.Println("\nBẢNG XẾP HẠNG REVIEWER:")
+	fmt.Println(separator)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "REVIEWER\tPR REVIEW\tAPPROVE\tCOMMENT/PR\tKEYWORD COVERAGE\tFIRST REVIEW (GIỜ, MEDIAN)\tĐẠT RULE")
+
+	for _, stats := range reviewers {
+		_, _ = fmt.Fprintf(w, "%s\t%d\t%d\t%.1f\t%.1f%%\t%s\t%d (%.1f%%)\n",
+			stats.Reviewer,
+			stats.PRsReviewed,
+			stats.Approvals,
+			stats.AvgComments,
+			stats.KeywordCoverage,
+			formatHours(stats.MedianFirstHours),
+			stats.RulePassed,
+			stats.RulePassRate)
+	}
+
+	_ = w.Flush()
+	fmt.Println(separator)
+}
+
+// ExportReviewerStatsCSV exports the reviewer leaderboard to CSV
+func (r *Reporter) ExportReviewerStatsCSV(filename string, reviewers []*ReviewerStats) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = file.Close() }()
+
+	_, _ = fmt.Fprintln(file, "reviewer,prs_reviewed,approvals,comments,avg_comments_per_pr,keyword_coverage,median_first_review_hours,rule_passed,rule_pass_rate")
+
+	for _, stats := range reviewers {
+		medianHours := ""
+		if stats.MedianFirstHours != nil {
+			medianHours = fmt.Sprintf("%.2f", *stats.MedianFirstHours)
+		}
+
+		_, _ = fmt.Fprintf(file, "\"%s\",%d,%d,%d,%.2f,%.2f,%s,%d,%.2f\n",
+			stats.Reviewer,
+			stats.PRsReviewed,
+			stats.Approvals,
+			stats.Comments,
+			stats.AvgComments,
+			stats.KeywordCoverage,
+			medianHours,
+			stats.RulePassed,
+			stats.RulePassRate,
+		)
+	}
+
+	fmt.Printf("\nBảng xếp hạng reviewer đã được export vào: %s\n", filename)
+	return nil
+}
+
+// ExportReviewerStatsJSON exports the reviewer leaderboard to JSON
+func (r *Reporter) ExportReviewerStatsJSON(filename string, reviewers []*ReviewerStats) error {
+	data, err := json.MarshalIndent(reviewers, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(filename, data, 0644); err != nil {
+		return err
+	}
+
+	fmt.Printf("Bảng xếp hạng reviewer đã được export vào: %s\n", filename)
+	return nil
+}
@@ -0,0 +1,115 @@
+package report
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bug-crawler/pkg/analyzer"
+	"github.com/bug-crawler/pkg/platform"
+)
+
+func reviewerTestResults() []*analyzer.PRRuleResult {
+	created := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	at := func(hours int) *time.Time {
+		t := created.Add(time.Duration(hours) * time.Hour)
+		return &t
+	}
+
+	prs := []*platform.PullRequestData{
+		{
+			Number:    1,
+			Author:    "author",
+			CreatedAt: created,
+			Reviews: []*platform.ReviewData{
+				{ReviewerLogin: "alice", State: "COMMENTED", SubmittedAt: at(2), CommentBody: "F1 OK, S1 OK"},
+				{ReviewerLogin: "alice", State: "APPROVED", SubmittedAt: at(3), CommentBody: "EH1 OK"},
+				{ReviewerLogin: "bob", State: "APPROVED", SubmittedAt: at(10)},
+				{ReviewerLogin: "author", State: "COMMENTED", SubmittedAt: at(1), CommentBody: "Functionality, security, error handling"},
+				{ReviewerLogin: "ci[bot]", State: "COMMENTED", SubmittedAt: at(0), CommentBody: "Build passed"},
+			},
+		},
+		{
+			Number:    2,
+			Author:    "author",
+			CreatedAt: created,
+			Reviews: []*platform.ReviewData{
+				{ReviewerLogin: "alice", State: "CHANGES_REQUESTED", SubmittedAt: at(4), CommentBody: "Security issue"},
+			},
+		},
+	}
+
+	return analyzer.NewPRRuleAnalyzer().AnalyzePRRules(prs)
+}
+
+func TestBuildReviewerStats(t *testing.T) {
+	reviewers := BuildReviewerStats(reviewerTestResults())
+
+	if len(reviewers) != 2 {
+		t.Fatalf("Got %d reviewers, want 2 (author and bots excluded): %+v", len(reviewers), reviewers)
+	}
+
+	alice, bob := reviewers[0], reviewers[1]
+	if alice.Reviewer != "alice" || bob.Reviewer != "bob" {
+		t.Fatalf("Order = %s, %s; want alice, bob", alice.Reviewer, bob.Reviewer)
+	}
+
+	if alice.PRsReviewed != 2 || alice.Approvals != 1 || alice.Comments != 3 || alice.AvgComments != 1.5 {
+		t.Errorf("alice activity = %+v", alice)
+	}
+	if alice.RulePassed != 1 || alice.RulePassRate != 50 {
+		t.Errorf("alice rule = %d (%.1f%%), want 1 (50%%)", alice.RulePassed, alice.RulePassRate)
+	}
+	// 3 of 5 keywords on PR 1, 1 of 5 on PR 2
+	if alice.KeywordCoverage != 40 {
+		t.Errorf("alice coverage = %.1f, want 40", alice.KeywordCoverage)
+	}
+	if alice.MedianFirstHours == nil || *alice.MedianFirstHours != 3 {
+		t.Errorf("alice median first review = %v, want 3", alice.MedianFirstHours)
+	}
+
+	if bob.PRsReviewed != 1 || bob.Approvals != 1 || bob.Comments != 0 || bob.RulePassed != 0 {
+		t.Errorf("bob = %+v", bob)
+	}
+}
+
+func TestExportReviewerStats(t *testing.T) {
+	reviewers := BuildReviewerStats(reviewerTestResults())
+	reporter := NewReporter()
+	dir := t.TempDir()
+
+	csvFile := filepath.Join(dir, "reviewers.csv")
+	if err := reporter.ExportReviewerStatsCSV(csvFile, reviewers); err != nil {
+		t.Fatalf("ExportReviewerStatsCSV failed: %v", err)
+	}
+	content, err := os.ReadFile(csvFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("Expected 3 lines in CSV, got %d", len(lines))
+	}
+	if lines[1] != `"alice",2,1,3,1.50,40.00,3.00,1,50.00` {
+		t.Errorf("Row mismatch: %s", lines[1])
+	}
+
+	jsonFile := filepath.Join(dir, "reviewers.json")
+	if err := reporter.ExportReviewerStatsJSON(jsonFile, reviewers); err != nil {
+		t.Fatalf("ExportReviewerStatsJSON failed: %v", err)
+	}
+	data, err := os.ReadFile(jsonFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var loaded []*ReviewerStats
+	if err := json.Unmarshal(data, &loaded); err != nil {
+		t.Fatalf("Invalid JSON: %v", err)
+	}
+	if len(loaded) != 2 || loaded[1].Reviewer != "bob" || loaded[1].MedianFirstHours == nil || *loaded[1].MedianFirstHours != 10 {
+		t.Errorf("Loaded = %+v", loaded)
+	}
+}
@@ -0,0 +1,108 @@
+package report
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/bug-crawler/pkg/analyzer"
+)
+
+// noScope is the key of PRs whose title has no Conventional Commits scope
+const noScope = "(none)"
+
+// ScopeStats contains the PRs and bug ratio of a Conventional Commits scope (component)
+type ScopeStats struct {
+	Scope    string  `json:"scope"`
+	PRs      int     `json:"prs"`
+	BugPRs   int     `json:"bug_prs"`
+	BugRatio float64 `json:"bug_ratio"`
+}
+
+// BuildScopeStats groups bug analysis results by the scope of their title, most bug PRs first.
+// It returns nil when no bug-related PR has a scope.
+func BuildScopeStats(results []*analyzer.BugResult) []*ScopeStats {
+	counters := make(map[string]*ratioCounter)
+	withScope := false
+
+	for _, result := range results {
+		scope := result.Scope
+		if scope == "" {
+			scope = noScope
+		} else if result.IsBugRelated {
+			withScope = true
+		}
+
+		counter := getRatioCounter(counters, scope)
+		counter.total++
+		if result.IsBugRelated {
+			counter.bugs++
+		}
+	}
+
+	if !withScope {
+		return nil
+	}
+
+	scopes := make([]*ScopeStats, 0, len(counters))
+	for scope, counter := range counters {
+		scopes = append(scopes, &ScopeStats{
+			Scope:    scope,
+			PRs:      counter.total,
+			BugPRs:   counter.bugs,
+			BugRatio: percentage(counter.bugs, counter.total),
+		})
+	}
+	sort.Slice(scopes, func(i, j int) bool {
+		// PRs without scope always come last
+		if (scopes[i].Scope == noScope) != (scopes[j].Scope == noScope) {
+			return scopes[j].Scope == noScope
+		}
+		if scopes[i].BugPRs != scopes[j].BugPRs {
+			return scopes[i].BugPRs > scopes[j].BugPRs
+		}
+		return scopes[i].Scope < scopes[j].Scope
+	})
+
+	return scopes
+}
+
+// PrintScopeStats prints the bug ratio per Conventional Commits scope
+func (r *Reporter) PrintScopeStats(scopes []*ScopeStats) {
+	if len(scopes) == 0 {
+		return
+	}
+
+	separator := "=========================================================================================================================="
+	fmt.Println("\nBUG THEO SCOPE (COMPONENT):")
+	fmt.Println(separator)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "SCOPE\tPR\tPR BUG\tTỶ LỆ BUG")
+
+	for _, stats := range scopes {
+		_, _ = fmt.Fprintf(w, "%s\t%d\t%d\t%.1f%%\n", stats.Scope, stats.PRs, stats.BugPRs, stats.BugRatio)
+	}
+
+	_ = w.Flush()
+	fmt.Println(separator)
+}
+
+// ExportScopeStatsCSV exports the bug ratio per Conventional Commits scope to CSV
+func (r *Reporter) ExportScopeStatsCSV(filename string, scopes []*ScopeStats) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = file.Close() }()
+
+	_, _ = fmt.Fprintln(file, "scope,prs,bug_prs,bug_ratio")
+
+	for _, stats := range scopes {
+		_, _ = fmt.Fprintf(file, "\"%s\",%d,%d,%.2f\n", stats.Scope, stats.PRs, stats.BugPRs, stats.BugRatio)
+	}
+
+	fmt.Printf("\nTỷ lệ bug theo scope đã được export vào: %s\n", filename)
+	return nil
+}
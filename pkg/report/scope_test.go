@@ -0,0 +1,40 @@
+package report
+
+import (
+	"testing"
+
+	"github.com/bug-crawler/pkg/analyzer"
+	"github.com/bug-crawler/pkg/platform"
+)
+
+func TestBuildScopeStats(t *testing.T) {
+	pr := &platform.PullRequestData{}
+	results := []*analyzer.BugResult{
+		{PR: pr, Scope: "api", IsBugRelated: true},
+		{PR: pr, Scope: "api"},
+		{PR: pr, Scope: "web", IsBugRelated: true},
+		{PR: pr, Scope: "web", IsBugRelated: true},
+		{PR: pr, IsBugRelated: true},
+		{PR: pr, Scope: "docs"},
+	}
+
+	scopes := BuildScopeStats(results)
+	want := []ScopeStats{
+		{Scope: "web", PRs: 2, BugPRs: 2, BugRatio: 100},
+		{Scope: "api", PRs: 2, BugPRs: 1, BugRatio: 50},
+		{Scope: "docs", PRs: 1, BugPRs: 0, BugRatio: 0},
+		{Scope: "(none)", PRs: 1, BugPRs: 1, BugRatio: 100},
+	}
+	if len(scopes) != len(want) {
+		t.Fatalf("BuildScopeStats() returned %d scopes, want %d", len(scopes), len(want))
+	}
+	for i := range want {
+		if *scopes[i] != want[i] {
+			t.Errorf("scopes[%d] = %+v, want %+v", i, *scopes[i], want[i])
+		}
+	}
+
+	if got := BuildScopeStats([]*analyzer.BugResult{{PR: pr, Scope: "api"}, {PR: pr, IsBugRelated: true}}); got != nil {
+		t.Errorf("BuildScopeStats() without bug scope = %+v, want nil", got)
+	}
+}
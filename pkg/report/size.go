@@ -0,0 +1,121 @@
+package report
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/bug-crawler/pkg/analyzer"
+)
+
+// SizeStats contains the PRs and bug ratio of a size bucket
+type SizeStats struct {
+	Size            string  `json:"size"`
+	PRs             int     `json:"prs"`
+	BugPRs          int     `json:"bug_prs"`
+	BugRatio        float64 `json:"bug_ratio"`
+	AvgChangedLines float64 `json:"avg_changed_lines"`
+	AvgChangedFiles float64 `json:"avg_changed_files"`
+	AvgCommits      float64 `json:"avg_commits"`
+}
+
+// BuildSizeStats groups bug analysis results by PR size bucket, in bucket order.
+// Empty buckets are left out; it returns nil when no PR has diff statistics.
+func BuildSizeStats(results []*analyzer.BugResult) []*SizeStats {
+	byBucket := make(map[string]*SizeStats)
+	withStats := false
+
+	for _, result := range results {
+		size := analyzer.SizeBucket(result.PR)
+		if size != analyzer.SizeUnknown {
+			withStats = true
+		}
+
+		stats, exists := byBucket[size]
+		if !exists {
+			stats = &SizeStats{Size: size}
+			byBucket[size] = stats
+		}
+		stats.PRs++
+		if result.IsBugRelated {
+			stats.BugPRs++
+		}
+		// Sums for now, averaged below
+		stats.AvgChangedLines += float64(analyzer.ChangedLines(result.PR))
+		stats.AvgChangedFiles += float64(result.PR.ChangedFiles)
+		stats.AvgCommits += float64(result.PR.Commits)
+	}
+
+	if !withStats {
+		return nil
+	}
+
+	sizes := make([]*SizeStats, 0, len(byBucket))
+	for _, size := range analyzer.SizeBuckets {
+		stats, exists := byBucket[size]
+		if !exists {
+			continue
+		}
+		stats.BugRatio = percentage(stats.BugPRs, stats.PRs)
+		stats.AvgChangedLines /= float64(stats.PRs)
+		stats.AvgChangedFiles /= float64(stats.PRs)
+		stats.AvgCommits /= float64(stats.PRs)
+		sizes = append(sizes, stats)
+	}
+
+	return sizes
+}
+
+// PrintSizeStats prints the bug ratio per PR size bucket
+func (r *Reporter) PrintSizeStats(sizes []*SizeStats) {
+	if len(sizes) == 0 {
+		return
+	}
+
+	separator := "=========================================================================================================================="
+	fmt.Println("\nTỶ LỆ BUG THEO KÍCH THƯỚC PR:")
+	fmt.Println(separator)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "SIZE\tPR\tPR BUG\tTỶ LỆ BUG\tDÒNG THAY ĐỔI (TB)\tFILE (TB)\tCOMMIT (TB)")
+
+	for _, stats := range sizes {
+		_, _ = fmt.Fprintf(w, "%s\t%d\t%d\t%.1f%%\t%.1f\t%.1f\t%.1f\n",
+			stats.Size,
+			stats.PRs,
+			stats.BugPRs,
+			stats.BugRatio,
+			stats.AvgChangedLines,
+			stats.AvgChangedFiles,
+			stats.AvgCommits)
+	}
+
+	_ = w.Flush()
+	fmt.Println(separator)
+}
+
+// ExportSizeStatsCSV exports the bug ratio per PR size bucket to CSV
+func (r *Reporter) ExportSizeStatsCSV(filename string, sizes []*SizeStats) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = file.Close() }()
+
+	_, _ = fmt.Fprintln(file, "size,prs,bug_prs,bug_ratio,avg_changed_lines,avg_changed_files,avg_commits")
+
+	for _, stats := range sizes {
+		_, _ = fmt.Fprintf(file, "%s,%d,%d,%.2f,%.2f,%.2f,%.2f\n",
+			stats.Size,
+			stats.PRs,
+			stats.BugPRs,
+			stats.BugRatio,
+			stats.AvgChangedLines,
+			stats.AvgChangedFiles,
+			stats.AvgCommits,
+		)
+	}
+
+	fmt.Printf("\nTỷ lệ bug theo kích thước PR đã được export vào: %s\n", filename)
+	return nil
+}
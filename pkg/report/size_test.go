@@ -0,0 +1,40 @@
+package report
+
+import (
+	"testing"
+
+	"github.com/bug-crawler/pkg/analyzer"
+	"github.com/bug-crawler/pkg/platform"
+)
+
+func TestBuildSizeStats(t *testing.T) {
+	results := []*analyzer.BugResult{
+		{PR: &platform.PullRequestData{Additions: 3, Deletions: 1, ChangedFiles: 1, Commits: 1}, IsBugRelated: true},
+		{PR: &platform.PullRequestData{Additions: 5, ChangedFiles: 2, Commits: 3}},
+		{PR: &platform.PullRequestData{Additions: 1500, Deletions: 100, ChangedFiles: 40, Commits: 12}, IsBugRelated: true},
+		{PR: &platform.PullRequestData{}},
+	}
+
+	sizes := BuildSizeStats(results)
+	if len(sizes) != 3 {
+		t.Fatalf("BuildSizeStats() returned %d buckets, want 3", len(sizes))
+	}
+
+	xs := sizes[0]
+	if xs.Size != analyzer.SizeXS || xs.PRs != 2 || xs.BugPRs != 1 || xs.BugRatio != 50 {
+		t.Errorf("XS = %+v", xs)
+	}
+	if xs.AvgChangedLines != 4.5 || xs.AvgChangedFiles != 1.5 || xs.AvgCommits != 2 {
+		t.Errorf("XS averages = %+v", xs)
+	}
+	if sizes[1].Size != analyzer.SizeXL || sizes[1].BugRatio != 100 {
+		t.Errorf("XL = %+v", sizes[1])
+	}
+	if sizes[2].Size != analyzer.SizeUnknown || sizes[2].PRs != 1 {
+		t.Errorf("unknown = %+v", sizes[2])
+	}
+
+	if got := BuildSizeStats([]*analyzer.BugResult{{PR: &platform.PullRequestData{}}}); got != nil {
+		t.Errorf("BuildSizeStats() without stats = %+v, want nil", got)
+	}
+}
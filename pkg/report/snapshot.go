@@ -0,0 +1,62 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/bug-crawler/pkg/analyzer"
+	"github.com/bug-crawler/pkg/platform"
+)
+
+// ScanSnapshot contains everything a scan produced, so it can be saved and compared later
+type ScanSnapshot struct {
+	Platform        string                   `json:"platform"`
+	ScanMode        string                   `json:"scan_mode"` // "bug", "pr_rules"
+	BugType         string                   `json:"bug_type,omitempty"`
+	Repositories    []string                 `json:"repositories"`
+	StartDate       time.Time                `json:"start_date"`
+	EndDate         time.Time                `json:"end_date"`
+	TotalPRsCrawled int                      `json:"total_prs_crawled"`      // PRs analyzed, excluded ones not counted
+	ExcludedPRs     map[string]int           `json:"excluded_prs,omitempty"` // PRs left out by the author filter, per reason
+	BugResults      []*analyzer.BugResult    `json:"bug_results,omitempty"`
+	PRRuleResults   []*analyzer.PRRuleResult `json:"pr_rule_results,omitempty"`
+	BugIssues       []*platform.IssueData    `json:"bug_issues,omitempty"` // Bug issues of the scanned projects (Backlog)
+}
+
+// PeriodLabel returns the date range of the snapshot in YYYY-MM-DD form
+func (s *ScanSnapshot) PeriodLabel() string {
+	// EndDate is exclusive (one day is added when prompting), so show the last included day
+	return fmt.Sprintf("%s → %s", s.StartDate.Format("2006-01-02"), s.EndDate.AddDate(0, 0, -1).Format("2006-01-02"))
+}
+
+// ExportJSON saves a scan snapshot to a JSON file
+func (r *Reporter) ExportJSON(filename string, snapshot *ScanSnapshot) error {
+	data, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(filename, data, 0644); err != nil {
+		return err
+	}
+
+	fmt.Printf("\nKết quả scan đã được lưu vào: %s\n", filename)
+	return nil
+}
+
+// LoadSnapshot loads a scan snapshot previously saved with ExportJSON
+func LoadSnapshot(filename string) (*ScanSnapshot, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshot ScanSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, fmt.Errorf("file %s không đúng định dạng: %w", filename, err)
+	}
+
+	return &snapshot, nil
+}